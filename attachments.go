@@ -2,12 +2,16 @@ package kivik
 
 import (
 	"bytes"
+	"crypto/md5"
 	"encoding/base64"
 	"encoding/json"
+	"hash"
 	"io"
 	"io/ioutil"
+	"strings"
 
 	"github.com/go-kivik/kivik/driver"
+	"github.com/go-kivik/kivik/errors"
 )
 
 // Attachments is a collection of one or more file attachments.
@@ -74,6 +78,48 @@ func (a *Attachment) validate() error {
 	return nil
 }
 
+// VerifyDigest wraps the attachment's Content, so that the MD5 sum of the
+// content read is compared against Digest when EOF is reached. On mismatch,
+// Read returns an error with StatusBadResponse in place of io.EOF. This guards
+// against truncated or corrupted downloads.
+//
+// VerifyDigest has no effect if Digest is not an MD5 digest, or if
+// ContentEncoding is set, as CouchDB calculates the digest of the encoded
+// content.
+func (a *Attachment) VerifyDigest() {
+	if a.Content == nil || a.ContentEncoding != "" || !strings.HasPrefix(a.Digest, "md5-") {
+		return
+	}
+	expected, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(a.Digest, "md5-"))
+	if err != nil {
+		return
+	}
+	a.Content = &digestReader{
+		ReadCloser: a.Content,
+		hash:       md5.New(),
+		expected:   expected,
+	}
+}
+
+// digestReader calculates the MD5 sum of the content read, and compares it
+// against the expected sum at EOF.
+type digestReader struct {
+	io.ReadCloser
+	hash     hash.Hash
+	expected []byte
+}
+
+var _ io.ReadCloser = &digestReader{}
+
+func (r *digestReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	_, _ = r.hash.Write(p[:n])
+	if err == io.EOF && !bytes.Equal(r.hash.Sum(nil), r.expected) {
+		return n, errors.Status(StatusBadResponse, "kivik: attachment digest mismatch")
+	}
+	return n, err
+}
+
 type jsonAttachment struct {
 	ContentType string `json:"content_type"`
 	Data        string `json:"data"`
@@ -139,7 +185,9 @@ func (a *Attachments) UnmarshalJSON(data []byte) error {
 }
 
 // AttachmentsIterator is an experimental way to read streamed attachments from
-// a multi-part Get request.
+// a multi-part Get request. As attachments are read sequentially from a single
+// response, each attachment's Content should be read before calling Next
+// again.
 type AttachmentsIterator struct {
 	atti driver.Attachments
 }
@@ -151,10 +199,6 @@ func (i *AttachmentsIterator) Next() (*Attachment, error) {
 	if err := i.atti.Next(att); err != nil {
 		return nil, err
 	}
-	return &Attachment{
-		Filename:    att.Filename,
-		ContentType: att.ContentType,
-		Content:     att.Content,
-		Size:        att.Size,
-	}, nil
+	a := Attachment(*att)
+	return &a, nil
 }
@@ -177,14 +177,22 @@ func TestAttachmentsIteratorNext(t *testing.T) {
 				atti: &mock.Attachments{
 					NextFunc: func(att *driver.Attachment) error {
 						*att = driver.Attachment{
-							Filename: "foo.txt",
+							Filename:    "foo.txt",
+							ContentType: "text/plain",
+							Size:        4,
+							Digest:      "md5-DLxmEfVUC9CAmjiNyVphWw==",
+							RevPos:      2,
 						}
 						return nil
 					},
 				},
 			},
 			expected: &Attachment{
-				Filename: "foo.txt",
+				Filename:    "foo.txt",
+				ContentType: "text/plain",
+				Size:        4,
+				Digest:      "md5-DLxmEfVUC9CAmjiNyVphWw==",
+				RevPos:      2,
 			},
 		},
 	}
@@ -198,3 +206,56 @@ func TestAttachmentsIteratorNext(t *testing.T) {
 		})
 	}
 }
+
+func TestAttachmentVerifyDigest(t *testing.T) {
+	tests := []struct {
+		name    string
+		att     *Attachment
+		content string
+		err     string
+	}{
+		{
+			name: "match",
+			att: &Attachment{
+				Digest:  "md5-DLxmEfVUC9CAmjiNyVphWw==",
+				Content: body("Test"),
+			},
+			content: "Test",
+		},
+		{
+			name: "mismatch",
+			att: &Attachment{
+				Digest:  "md5-DLxmEfVUC9CAmjiNyVphWw==",
+				Content: body("Tes"),
+			},
+			err: "kivik: attachment digest mismatch",
+		},
+		{
+			name: "encoded",
+			att: &Attachment{
+				Digest:          "md5-DLxmEfVUC9CAmjiNyVphWw==",
+				ContentEncoding: "gzip",
+				Content:         body("Tes"),
+			},
+			content: "Tes",
+		},
+		{
+			name: "non-MD5 digest",
+			att: &Attachment{
+				Digest:  "sha1-foo",
+				Content: body("Tes"),
+			},
+			content: "Tes",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.att.VerifyDigest()
+			content, err := ioutil.ReadAll(test.att.Content)
+			testy.Error(t, test.err, err)
+			if d := diff.Text(test.content, string(content)); d != nil {
+				t.Error(d)
+			}
+		})
+	}
+}
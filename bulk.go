@@ -82,6 +82,49 @@ func (r *BulkResults) UpdateErr() error {
 	return r.curVal.(*driver.BulkResult).Error
 }
 
+// BulkResult is the result of a single document update in a BulkDocs call.
+type BulkResult driver.BulkResult
+
+// BulkResultSet is a collection of BulkResults, as returned by
+// BulkResults.All.
+type BulkResultSet []BulkResult
+
+// Errors returns only those results which failed, such as conflicts.
+func (s BulkResultSet) Errors() []BulkResult {
+	var failed []BulkResult
+	for _, result := range s {
+		if result.Error != nil {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+// Ok returns true if every document in the set was updated successfully.
+func (s BulkResultSet) Ok() bool {
+	for _, result := range s {
+		if result.Error != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// All reads the remaining results from the iterator, and closes it. Failures of
+// individual documents are recorded in each result's Error field; the
+// returned error is reserved for failures of the iterator itself.
+func (r *BulkResults) All() (BulkResultSet, error) {
+	var set BulkResultSet
+	for r.Next() {
+		set = append(set, BulkResult{
+			ID:    r.ID(),
+			Rev:   r.Rev(),
+			Error: r.UpdateErr(),
+		})
+	}
+	return set, r.Err()
+}
+
 // BulkDocs allows you to create and update multiple documents at the same time
 // within a single request. This function returns an iterator over the results
 // of the bulk operation. docs must be a slice, array, or pointer to a slice
@@ -90,6 +133,11 @@ func (r *BulkResults) UpdateErr() error {
 //
 // As with Put, each individual document may be a JSON-marshable object, or a
 // raw JSON string in a []byte, json.RawMessage, or io.Reader.
+//
+// Failures of individual documents, such as conflicts, do not cause BulkDocs
+// to fail; they are reported for each result by UpdateErr, and may be
+// collected with All. To store documents with caller-supplied revisions, as a
+// replicator does, pass the `new_edits=false` option.
 func (db *DB) BulkDocs(ctx context.Context, docs interface{}, options ...Options) (*BulkResults, error) {
 	opts, err := mergeOptions(options...)
 	if err != nil {
@@ -10,6 +10,7 @@ import (
 	"github.com/flimzy/diff"
 	"github.com/flimzy/testy"
 	"github.com/go-kivik/kivik/driver"
+	kerrors "github.com/go-kivik/kivik/errors"
 	"github.com/go-kivik/kivik/mock"
 )
 
@@ -504,3 +505,59 @@ func TestBulkResultsGetters(t *testing.T) {
 
 	})
 }
+
+func TestBulkResultsAll(t *testing.T) {
+	t.Run("partial failure", func(t *testing.T) {
+		conflict := kerrors.Status(StatusConflict, "document update conflict")
+		results := []driver.BulkResult{
+			{ID: "foo", Rev: "1-xxx"},
+			{ID: "bar", Error: conflict},
+			{ID: "baz", Rev: "2-yyy"},
+		}
+		var i int
+		r := newBulkResults(context.Background(), &mock.BulkResults{
+			NextFunc: func(result *driver.BulkResult) error {
+				if i >= len(results) {
+					return io.EOF
+				}
+				*result = results[i]
+				i++
+				return nil
+			},
+			CloseFunc: func() error { return nil },
+		})
+		set, err := r.All()
+		testy.Error(t, "", err)
+		expected := BulkResultSet{
+			{ID: "foo", Rev: "1-xxx"},
+			{ID: "bar", Error: conflict},
+			{ID: "baz", Rev: "2-yyy"},
+		}
+		if d := diff.Interface(expected, set); d != nil {
+			t.Error(d)
+		}
+		if set.Ok() {
+			t.Error("Expected Ok() to be false")
+		}
+		if d := diff.Interface([]BulkResult{{ID: "bar", Error: conflict}}, set.Errors()); d != nil {
+			t.Error(d)
+		}
+	})
+	t.Run("all succeed", func(t *testing.T) {
+		set := BulkResultSet{{ID: "foo", Rev: "1-xxx"}}
+		if !set.Ok() {
+			t.Error("Expected Ok() to be true")
+		}
+		if errs := set.Errors(); errs != nil {
+			t.Errorf("Unexpected errors: %v", errs)
+		}
+	})
+	t.Run("iterator error", func(t *testing.T) {
+		r := newBulkResults(context.Background(), &mock.BulkResults{
+			NextFunc:  func(_ *driver.BulkResult) error { return errors.New("read error") },
+			CloseFunc: func() error { return nil },
+		})
+		_, err := r.All()
+		testy.Error(t, "read error", err)
+	})
+}
@@ -0,0 +1,53 @@
+package kivik
+
+import (
+	"context"
+
+	"github.com/go-kivik/kivik/driver"
+	"github.com/go-kivik/kivik/errors"
+)
+
+// BulkGetReference is a reference to a document given in a BulkGet query.
+type BulkGetReference struct {
+	// ID is the document ID to fetch.
+	ID string `json:"id"`
+	// Rev is the revision to fetch. If empty, the current revision is
+	// returned.
+	Rev string `json:"rev,omitempty"`
+	// AttsSince is a revision from which attachments should be included.
+	AttsSince string `json:"atts_since,omitempty"`
+}
+
+// BulkGet can be called to query several documents in bulk. It is well suited
+// for fetching a specific revision of documents, as replicators do for example,
+// or for getting revision history. Documents which could not be fetched are
+// returned as rows whose ScanDoc method returns the per-document error.
+//
+// With attachments=true, attachment data is included inline in each document,
+// limited to attachments changed since AttsSince, when set. Drivers are
+// responsible for decoding any multipart response the server sends in this
+// case.
+//
+// See http://docs.couchdb.org/en/2.1.1/api/database/bulk-api.html#db-bulk-get
+func (db *DB) BulkGet(ctx context.Context, docs []BulkGetReference, options ...Options) (*Rows, error) {
+	bulkGetter, ok := db.driverDB.(driver.BulkGetter)
+	if !ok {
+		return nil, errors.Status(StatusNotImplemented, "kivik: bulk get not supported by driver")
+	}
+	if len(docs) == 0 {
+		return nil, errors.Status(StatusBadAPICall, "kivik: no documents provided")
+	}
+	opts, err := mergeOptions(options...)
+	if err != nil {
+		return nil, err
+	}
+	refs := make([]driver.BulkGetReference, len(docs))
+	for i, ref := range docs {
+		refs[i] = driver.BulkGetReference(ref)
+	}
+	rowsi, err := bulkGetter.BulkGet(ctx, refs, opts)
+	if err != nil {
+		return nil, err
+	}
+	return newRows(ctx, rowsi), nil
+}
@@ -0,0 +1,94 @@
+package kivik
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/flimzy/diff"
+	"github.com/flimzy/testy"
+	"github.com/go-kivik/kivik/driver"
+	"github.com/go-kivik/kivik/mock"
+)
+
+func TestBulkGet(t *testing.T) {
+	tests := []struct {
+		name     string
+		db       *DB
+		docs     []BulkGetReference
+		options  Options
+		expected *Rows
+		status   int
+		err      string
+	}{
+		{
+			name: "non-bulkGetter",
+			db: &DB{
+				driverDB: &mock.DB{},
+			},
+			docs:   []BulkGetReference{{ID: "foo"}},
+			status: StatusNotImplemented,
+			err:    "kivik: bulk get not supported by driver",
+		},
+		{
+			name: "no docs",
+			db: &DB{
+				driverDB: &mock.BulkGetter{},
+			},
+			status: StatusBadAPICall,
+			err:    "kivik: no documents provided",
+		},
+		{
+			name: "db error",
+			db: &DB{
+				driverDB: &mock.BulkGetter{
+					BulkGetFunc: func(_ context.Context, _ []driver.BulkGetReference, _ map[string]interface{}) (driver.Rows, error) {
+						return nil, errors.New("db error")
+					},
+				},
+			},
+			docs:   []BulkGetReference{{ID: "foo"}},
+			status: StatusInternalServerError,
+			err:    "db error",
+		},
+		{
+			name: "success",
+			db: &DB{
+				driverDB: &mock.BulkGetter{
+					BulkGetFunc: func(_ context.Context, docs []driver.BulkGetReference, opts map[string]interface{}) (driver.Rows, error) {
+						expectedDocs := []driver.BulkGetReference{{ID: "foo", Rev: "1-xxx"}, {ID: "bar", AttsSince: "2-yyy"}}
+						if d := diff.Interface(expectedDocs, docs); d != nil {
+							return nil, fmt.Errorf("Unexpected docs:\n%s", d)
+						}
+						if d := diff.Interface(testOptions, opts); d != nil {
+							return nil, fmt.Errorf("Unexpected options:\n%s", d)
+						}
+						return &mock.Rows{ID: "a"}, nil
+					},
+				},
+			},
+			docs:    []BulkGetReference{{ID: "foo", Rev: "1-xxx"}, {ID: "bar", AttsSince: "2-yyy"}},
+			options: testOptions,
+			expected: &Rows{
+				iter: &iter{
+					feed: &rowsIterator{
+						Rows: &mock.Rows{ID: "a"},
+					},
+					curVal: &driver.Row{},
+				},
+				rowsi: &mock.Rows{ID: "a"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := test.db.BulkGet(context.Background(), test.docs, test.options)
+			testy.StatusError(t, test.err, test.status, err)
+			result.cancel = nil // Determinism
+			if d := diff.Interface(test.expected, result); d != nil {
+				t.Error(d)
+			}
+		})
+	}
+}
@@ -20,7 +20,9 @@ func (c *Changes) Next() bool {
 }
 
 // Err returns the error, if any, that was encountered during iteration. Err may
-// be called after an explicit or implicit Close.
+// be called after an explicit or implicit Close. If the feed timed out, because
+// no data or heartbeat was received in time, the error will have status
+// StatusRequestTimeout.
 func (c *Changes) Err() error {
 	return c.iter.Err()
 }
@@ -62,6 +64,22 @@ func (c *Changes) ID() string {
 	return c.curVal.(*driver.Change).ID
 }
 
+// Seq returns the update sequence of the current result.
+func (c *Changes) Seq() string {
+	return string(c.curVal.(*driver.Change).Seq)
+}
+
+// LastSeq returns the last update sequence reported by the changes feed. It is
+// only guaranteed to be set after all results have been enumerated through by
+// Next, and thus should only be read after the feed has been exhausted. An
+// empty string is returned if the driver does not report the last sequence.
+func (c *Changes) LastSeq() string {
+	if l, ok := c.changesi.(driver.LastSeqer); ok {
+		return l.LastSeq()
+	}
+	return ""
+}
+
 // ScanDoc works the same as ScanValue, but on the doc field of the result. It
 // is only valid for results that include documents.
 func (c *Changes) ScanDoc(dest interface{}) error {
@@ -74,7 +92,15 @@ func (c *Changes) ScanDoc(dest interface{}) error {
 }
 
 // Changes returns an iterator over the real-time changes feed. The feed remains
-// open until explicitly closed, or an error is encountered.
+// open until explicitly closed, or an error is encountered. For long-lived
+// feeds, the `heartbeat` option may be used to keep the connection alive;
+// heartbeats are never returned as results.
+//
+// To receive only matching changes, pass filter, naming a design document
+// filter function as "ddoc/filter", along with any query parameters it
+// requires. CouchDB's built-in "_doc_ids" and "_selector" filters take their
+// doc_ids and selector options from the request body, which drivers are
+// expected to handle.
 // See http://couchdb.readthedocs.io/en/latest/api/database/changes.html#get--db-_changes
 func (db *DB) Changes(ctx context.Context, options ...Options) (*Changes, error) {
 	opts, err := mergeOptions(options...)
@@ -9,6 +9,7 @@ import (
 	"github.com/flimzy/diff"
 	"github.com/flimzy/testy"
 	"github.com/go-kivik/kivik/driver"
+	kerrors "github.com/go-kivik/kivik/errors"
 	"github.com/go-kivik/kivik/mock"
 )
 
@@ -56,6 +57,19 @@ func TestChangesErr(t *testing.T) {
 	testy.Error(t, expected, err)
 }
 
+func TestChangesTimeout(t *testing.T) {
+	c := newChanges(context.Background(), &mock.Changes{
+		NextFunc: func(_ *driver.Change) error {
+			return kerrors.Status(StatusRequestTimeout, "timeout")
+		},
+		CloseFunc: func() error { return nil },
+	})
+	if c.Next() {
+		t.Fatal("Expected Next to return false")
+	}
+	testy.StatusError(t, "timeout", StatusRequestTimeout, c.Err())
+}
+
 func TestChangesClose(t *testing.T) {
 	expected := "close error"
 	c := &Changes{
@@ -103,6 +117,7 @@ func TestChangesGetters(t *testing.T) {
 				ID:      "foo",
 				Deleted: true,
 				Changes: []string{"1", "2", "3"},
+				Seq:     "2-foo",
 			},
 		},
 	}
@@ -130,6 +145,43 @@ func TestChangesGetters(t *testing.T) {
 			t.Errorf("Unexpected result: %v", result)
 		}
 	})
+
+	t.Run("Seq", func(t *testing.T) {
+		expected := "2-foo"
+		result := c.Seq()
+		if expected != result {
+			t.Errorf("Unexpected result: %v", result)
+		}
+	})
+}
+
+func TestChangesLastSeq(t *testing.T) {
+	tests := []struct {
+		name     string
+		changes  *Changes
+		expected string
+	}{
+		{
+			name:     "non-LastSeqer",
+			changes:  &Changes{changesi: &mock.Changes{}},
+			expected: "",
+		},
+		{
+			name: "LastSeqer",
+			changes: &Changes{changesi: &mock.LastSeqer{
+				LastSeqFunc: func() string { return "3-bar" },
+			}},
+			expected: "3-bar",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := test.changes.LastSeq()
+			if result != test.expected {
+				t.Errorf("Unexpected result: %v", result)
+			}
+		})
+	}
 }
 
 func TestChangesScanDoc(t *testing.T) {
@@ -0,0 +1,75 @@
+package kivik
+
+import (
+	"context"
+	"time"
+)
+
+// checkpointBatchSize is the number of changes after which a checkpoint is
+// stored, and checkpointInterval the time after which a checkpoint is stored
+// regardless of the number of changes.
+var (
+	checkpointBatchSize = 100
+	checkpointInterval  = 5 * time.Second
+)
+
+// checkpoint records progress through a changes feed, in a local document, as
+// used by Follow and ReplicateLocal.
+type checkpoint struct {
+	Rev     string `json:"_rev,omitempty"`
+	LastSeq string `json:"last_seq"`
+
+	db      *DB
+	docID   string
+	pending int
+	saved   time.Time
+}
+
+// loadCheckpoint reads the checkpoint stored in _local/{id} in db. A missing
+// checkpoint is not an error.
+func loadCheckpoint(ctx context.Context, db *DB, id string) (*checkpoint, error) {
+	cp := &checkpoint{
+		db:    db,
+		docID: "_local/" + id,
+		saved: time.Now(),
+	}
+	if err := db.Get(ctx, cp.docID).ScanDoc(cp); err != nil && !IsNotFound(err) {
+		return nil, err
+	}
+	return cp, nil
+}
+
+// update records seq as processed, storing the checkpoint once
+// checkpointBatchSize changes, or checkpointInterval, have passed since it
+// was last stored.
+func (cp *checkpoint) update(ctx context.Context, seq string) error {
+	cp.LastSeq = seq
+	cp.pending++
+	if cp.pending < checkpointBatchSize && time.Since(cp.saved) < checkpointInterval {
+		return nil
+	}
+	return cp.flush(ctx)
+}
+
+// flush stores the checkpoint, if any changes have been recorded since it was
+// last stored. If ctx has already been cancelled, as when the caller is
+// shutting down, a fresh context is used, so that the progress made is not
+// lost.
+func (cp *checkpoint) flush(ctx context.Context) error {
+	if cp.pending == 0 {
+		return nil
+	}
+	if ctx.Err() != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.Background(), checkpointInterval)
+		defer cancel()
+	}
+	rev, err := cp.db.Put(ctx, cp.docID, cp)
+	if err != nil {
+		return err
+	}
+	cp.Rev = rev
+	cp.pending = 0
+	cp.saved = time.Now()
+	return nil
+}
@@ -0,0 +1,69 @@
+package kivik
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/flimzy/diff"
+	"github.com/flimzy/testy"
+	"github.com/go-kivik/kivik/driver"
+	"github.com/go-kivik/kivik/errors"
+	"github.com/go-kivik/kivik/mock"
+)
+
+func TestCheckpoint(t *testing.T) {
+	defer func(size int, interval time.Duration) {
+		checkpointBatchSize, checkpointInterval = size, interval
+	}(checkpointBatchSize, checkpointInterval)
+	checkpointBatchSize = 2
+	checkpointInterval = time.Hour
+
+	var saved []string
+	db := &DB{driverDB: &mock.DB{
+		GetFunc: func(_ context.Context, docID string, _ map[string]interface{}) (*driver.Document, error) {
+			if docID != "_local/foo" {
+				return nil, fmt.Errorf("Unexpected checkpoint ID: %s", docID)
+			}
+			return nil, errors.Status(StatusNotFound, "missing")
+		},
+		PutFunc: checkpointSaver(&saved),
+	}}
+	ctx, cancel := context.WithCancel(context.Background())
+	cp, err := loadCheckpoint(ctx, db, "foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, seq := range []string{"1", "2", "3"} {
+		if err := cp.update(ctx, seq); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if d := diff.Interface([]string{":2"}, saved); d != nil {
+		t.Errorf("Unexpected checkpoints after batch:\n%s", d)
+	}
+	cancel()
+	if err := cp.flush(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err := cp.flush(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if d := diff.Interface([]string{":2", "0-2:3"}, saved); d != nil {
+		t.Errorf("Unexpected checkpoints after flush:\n%s", d)
+	}
+
+	t.Run("interval", func(t *testing.T) {
+		checkpointInterval = 0
+		var saved []string
+		cp := &checkpoint{
+			db:    &DB{driverDB: &mock.DB{PutFunc: checkpointSaver(&saved)}},
+			docID: "_local/foo",
+		}
+		testy.Error(t, "", cp.update(context.Background(), "1"))
+		if d := diff.Interface([]string{":1"}, saved); d != nil {
+			t.Error(d)
+		}
+	})
+}
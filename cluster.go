@@ -0,0 +1,38 @@
+package kivik
+
+import (
+	"context"
+
+	"github.com/go-kivik/kivik/driver"
+	"github.com/go-kivik/kivik/errors"
+)
+
+// ClusterMembership contains the list of known nodes, and cluster nodes, as
+// returned by the /_membership endpoint.
+// See http://docs.couchdb.org/en/2.1.1/api/server/common.html#get--_membership
+type ClusterMembership struct {
+	// AllNodes is the list of all nodes known to the node handling the
+	// request.
+	AllNodes []string `json:"all_nodes"`
+	// ClusterNodes is the list of nodes which are part of the cluster.
+	ClusterNodes []string `json:"cluster_nodes"`
+}
+
+// Membership returns the list of nodes that are part of the cluster. For
+// servers which do not support clustering, such as CouchDB 1.x, an error with
+// status StatusNotImplemented is returned.
+func (c *Client) Membership(ctx context.Context) (*ClusterMembership, error) {
+	if err := c.checkClosed(); err != nil {
+		return nil, err
+	}
+	cluster, ok := c.driverClient.(driver.Cluster)
+	if !ok {
+		return nil, errors.Status(StatusNotImplemented, "kivik: driver does not support cluster operations")
+	}
+	nodes, err := cluster.Membership(ctx)
+	if err != nil {
+		return nil, err
+	}
+	m := ClusterMembership(*nodes)
+	return &m, nil
+}
@@ -0,0 +1,69 @@
+package kivik
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/flimzy/diff"
+	"github.com/flimzy/testy"
+	"github.com/go-kivik/kivik/driver"
+	"github.com/go-kivik/kivik/mock"
+)
+
+func TestMembership(t *testing.T) {
+	tests := []struct {
+		name     string
+		client   *Client
+		expected *ClusterMembership
+		status   int
+		err      string
+	}{
+		{
+			name: "non-Cluster",
+			client: &Client{
+				driverClient: &mock.Client{},
+			},
+			status: StatusNotImplemented,
+			err:    "kivik: driver does not support cluster operations",
+		},
+		{
+			name: "error",
+			client: &Client{
+				driverClient: &mock.Cluster{
+					MembershipFunc: func(_ context.Context) (*driver.ClusterMembership, error) {
+						return nil, errors.New("membership error")
+					},
+				},
+			},
+			status: StatusInternalServerError,
+			err:    "membership error",
+		},
+		{
+			name: "success",
+			client: &Client{
+				driverClient: &mock.Cluster{
+					MembershipFunc: func(_ context.Context) (*driver.ClusterMembership, error) {
+						return &driver.ClusterMembership{
+							AllNodes:     []string{"one", "two", "three"},
+							ClusterNodes: []string{"one", "two"},
+						}, nil
+					},
+				},
+			},
+			expected: &ClusterMembership{
+				AllNodes:     []string{"one", "two", "three"},
+				ClusterNodes: []string{"one", "two"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := test.client.Membership(context.Background())
+			testy.StatusError(t, test.err, test.status, err)
+			if d := diff.Interface(test.expected, result); d != nil {
+				t.Error(d)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package kivik
+
+import (
+	"context"
+
+	"github.com/go-kivik/kivik/driver"
+	"github.com/go-kivik/kivik/errors"
+)
+
+// LocalNode is the node name which refers to the node to which the client is
+// connected. It is used by the Config methods when no node is specified.
+const LocalNode = "_local"
+
+// Config represents all the config sections.
+type Config map[string]ConfigSection
+
+// ConfigSection represents all key/value pairs for a section of configuration.
+type ConfigSection map[string]string
+
+var configNotImplemented = errors.Status(StatusNotImplemented, "kivik: driver does not support Config interface")
+
+func (c *Client) configer() (driver.Configer, error) {
+	if err := c.checkClosed(); err != nil {
+		return nil, err
+	}
+	if configer, ok := c.driverClient.(driver.Configer); ok {
+		return configer, nil
+	}
+	return nil, configNotImplemented
+}
+
+func nodeName(node string) string {
+	if node == "" {
+		return LocalNode
+	}
+	return node
+}
+
+// Config returns the entire server config, for the specified node. If node is
+// empty, LocalNode is used. For servers without per-node configuration, such
+// as CouchDB 1.x, node is ignored.
+//
+// See http://docs.couchdb.org/en/2.1.1/api/server/configuration.html#get--_node-node-name-_config
+func (c *Client) Config(ctx context.Context, node string) (Config, error) {
+	configer, err := c.configer()
+	if err != nil {
+		return nil, err
+	}
+	driverConf, err := configer.Config(ctx, nodeName(node))
+	if err != nil {
+		return nil, err
+	}
+	conf := Config{}
+	for k, v := range driverConf {
+		conf[k] = ConfigSection(v)
+	}
+	return conf, nil
+}
+
+// ConfigSection returns the requested section of the server config for the
+// specified node.
+//
+// See http://docs.couchdb.org/en/2.1.1/api/server/configuration.html#node-node-name-config-section
+func (c *Client) ConfigSection(ctx context.Context, node, section string) (ConfigSection, error) {
+	if section == "" {
+		return nil, missingArg("section")
+	}
+	configer, err := c.configer()
+	if err != nil {
+		return nil, err
+	}
+	sec, err := configer.ConfigSection(ctx, nodeName(node), section)
+	if err != nil {
+		return nil, err
+	}
+	return ConfigSection(sec), nil
+}
+
+// ConfigValue returns a single config value for the specified node.
+//
+// See http://docs.couchdb.org/en/2.1.1/api/server/configuration.html#get--_node-node-name-_config-section-key
+func (c *Client) ConfigValue(ctx context.Context, node, section, key string) (string, error) {
+	if section == "" {
+		return "", missingArg("section")
+	}
+	if key == "" {
+		return "", missingArg("key")
+	}
+	configer, err := c.configer()
+	if err != nil {
+		return "", err
+	}
+	return configer.ConfigValue(ctx, nodeName(node), section, key)
+}
+
+// SetConfigValue sets the server's config value on the specified node,
+// returning the old value.
+//
+// See http://docs.couchdb.org/en/2.1.1/api/server/configuration.html#put--_node-node-name-_config-section-key
+func (c *Client) SetConfigValue(ctx context.Context, node, section, key, value string) (string, error) {
+	if section == "" {
+		return "", missingArg("section")
+	}
+	if key == "" {
+		return "", missingArg("key")
+	}
+	configer, err := c.configer()
+	if err != nil {
+		return "", err
+	}
+	return configer.SetConfigValue(ctx, nodeName(node), section, key, value)
+}
+
+// DeleteConfigValue deletes a configuration key from the specified node,
+// returning the old value.
+//
+// See http://docs.couchdb.org/en/2.1.1/api/server/configuration.html#delete--_node-node-name-_config-section-key
+func (c *Client) DeleteConfigValue(ctx context.Context, node, section, key string) (string, error) {
+	if section == "" {
+		return "", missingArg("section")
+	}
+	if key == "" {
+		return "", missingArg("key")
+	}
+	configer, err := c.configer()
+	if err != nil {
+		return "", err
+	}
+	return configer.DeleteConfigValue(ctx, nodeName(node), section, key)
+}
@@ -0,0 +1,356 @@
+package kivik
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/flimzy/diff"
+	"github.com/flimzy/testy"
+	"github.com/go-kivik/kivik/driver"
+	"github.com/go-kivik/kivik/mock"
+)
+
+func TestConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		client   *Client
+		node     string
+		expected Config
+		status   int
+		err      string
+	}{
+		{
+			name: "non-Configer",
+			client: &Client{
+				driverClient: &mock.Client{},
+			},
+			status: StatusNotImplemented,
+			err:    "kivik: driver does not support Config interface",
+		},
+		{
+			name: "error",
+			client: &Client{
+				driverClient: &mock.Configer{
+					ConfigFunc: func(_ context.Context, _ string) (driver.Config, error) {
+						return nil, errors.New("conf error")
+					},
+				},
+			},
+			status: StatusInternalServerError,
+			err:    "conf error",
+		},
+		{
+			name: "default node",
+			client: &Client{
+				driverClient: &mock.Configer{
+					ConfigFunc: func(_ context.Context, node string) (driver.Config, error) {
+						if node != LocalNode {
+							return nil, fmt.Errorf("Unexpected node: %s", node)
+						}
+						return driver.Config{
+							"foo": driver.ConfigSection{"asdf": "rew"},
+						}, nil
+					},
+				},
+			},
+			expected: Config{
+				"foo": ConfigSection{"asdf": "rew"},
+			},
+		},
+		{
+			name: "named node",
+			client: &Client{
+				driverClient: &mock.Configer{
+					ConfigFunc: func(_ context.Context, node string) (driver.Config, error) {
+						if node != "node1" {
+							return nil, fmt.Errorf("Unexpected node: %s", node)
+						}
+						return driver.Config{}, nil
+					},
+				},
+			},
+			node:     "node1",
+			expected: Config{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := test.client.Config(context.Background(), test.node)
+			testy.StatusError(t, test.err, test.status, err)
+			if d := diff.Interface(test.expected, result); d != nil {
+				t.Error(d)
+			}
+		})
+	}
+}
+
+func TestConfigSection(t *testing.T) {
+	tests := []struct {
+		name          string
+		client        *Client
+		node, section string
+		expected      ConfigSection
+		status        int
+		err           string
+	}{
+		{
+			name:   "no section",
+			status: StatusBadRequest,
+			err:    "kivik: section required",
+		},
+		{
+			name: "non-Configer",
+			client: &Client{
+				driverClient: &mock.Client{},
+			},
+			section: "foo",
+			status:  StatusNotImplemented,
+			err:     "kivik: driver does not support Config interface",
+		},
+		{
+			name: "error",
+			client: &Client{
+				driverClient: &mock.Configer{
+					ConfigSectionFunc: func(_ context.Context, _, _ string) (driver.ConfigSection, error) {
+						return nil, errors.New("conf error")
+					},
+				},
+			},
+			section: "foo",
+			status:  StatusInternalServerError,
+			err:     "conf error",
+		},
+		{
+			name: "success",
+			client: &Client{
+				driverClient: &mock.Configer{
+					ConfigSectionFunc: func(_ context.Context, node, section string) (driver.ConfigSection, error) {
+						if node != "node1" {
+							return nil, fmt.Errorf("Unexpected node: %s", node)
+						}
+						if section != "foo" {
+							return nil, fmt.Errorf("Unexpected section: %s", section)
+						}
+						return driver.ConfigSection{"lkjlkj": "asdf"}, nil
+					},
+				},
+			},
+			node:     "node1",
+			section:  "foo",
+			expected: ConfigSection{"lkjlkj": "asdf"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := test.client.ConfigSection(context.Background(), test.node, test.section)
+			testy.StatusError(t, test.err, test.status, err)
+			if d := diff.Interface(test.expected, result); d != nil {
+				t.Error(d)
+			}
+		})
+	}
+}
+
+func TestConfigValue(t *testing.T) {
+	tests := []struct {
+		name               string
+		client             *Client
+		node, section, key string
+		expected           string
+		status             int
+		err                string
+	}{
+		{
+			name:   "no section",
+			status: StatusBadRequest,
+			err:    "kivik: section required",
+		},
+		{
+			name:    "no key",
+			section: "foo",
+			status:  StatusBadRequest,
+			err:     "kivik: key required",
+		},
+		{
+			name: "non-Configer",
+			client: &Client{
+				driverClient: &mock.Client{},
+			},
+			section: "foo",
+			key:     "bar",
+			status:  StatusNotImplemented,
+			err:     "kivik: driver does not support Config interface",
+		},
+		{
+			name: "success",
+			client: &Client{
+				driverClient: &mock.Configer{
+					ConfigValueFunc: func(_ context.Context, node, section, key string) (string, error) {
+						if node != LocalNode {
+							return "", fmt.Errorf("Unexpected node: %s", node)
+						}
+						if section != "foo" {
+							return "", fmt.Errorf("Unexpected section: %s", section)
+						}
+						if key != "bar" {
+							return "", fmt.Errorf("Unexpected key: %s", key)
+						}
+						return "baz", nil
+					},
+				},
+			},
+			section:  "foo",
+			key:      "bar",
+			expected: "baz",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := test.client.ConfigValue(context.Background(), test.node, test.section, test.key)
+			testy.StatusError(t, test.err, test.status, err)
+			if result != test.expected {
+				t.Errorf("Unexpected result: %s", result)
+			}
+		})
+	}
+}
+
+func TestSetConfigValue(t *testing.T) {
+	tests := []struct {
+		name                      string
+		client                    *Client
+		node, section, key, value string
+		expected                  string
+		status                    int
+		err                       string
+	}{
+		{
+			name:   "no section",
+			status: StatusBadRequest,
+			err:    "kivik: section required",
+		},
+		{
+			name:    "no key",
+			section: "foo",
+			status:  StatusBadRequest,
+			err:     "kivik: key required",
+		},
+		{
+			name: "non-Configer",
+			client: &Client{
+				driverClient: &mock.Client{},
+			},
+			section: "foo",
+			key:     "bar",
+			status:  StatusNotImplemented,
+			err:     "kivik: driver does not support Config interface",
+		},
+		{
+			name: "success",
+			client: &Client{
+				driverClient: &mock.Configer{
+					SetConfigValueFunc: func(_ context.Context, node, section, key, value string) (string, error) {
+						if node != "node1" {
+							return "", fmt.Errorf("Unexpected node: %s", node)
+						}
+						if section != "foo" || key != "bar" || value != "baz" {
+							return "", fmt.Errorf("Unexpected arguments: %s/%s=%s", section, key, value)
+						}
+						return "old", nil
+					},
+				},
+			},
+			node:     "node1",
+			section:  "foo",
+			key:      "bar",
+			value:    "baz",
+			expected: "old",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := test.client.SetConfigValue(context.Background(), test.node, test.section, test.key, test.value)
+			testy.StatusError(t, test.err, test.status, err)
+			if result != test.expected {
+				t.Errorf("Unexpected result: %s", result)
+			}
+		})
+	}
+}
+
+func TestDeleteConfigValue(t *testing.T) {
+	tests := []struct {
+		name               string
+		client             *Client
+		node, section, key string
+		expected           string
+		status             int
+		err                string
+	}{
+		{
+			name:   "no section",
+			status: StatusBadRequest,
+			err:    "kivik: section required",
+		},
+		{
+			name:    "no key",
+			section: "foo",
+			status:  StatusBadRequest,
+			err:     "kivik: key required",
+		},
+		{
+			name: "non-Configer",
+			client: &Client{
+				driverClient: &mock.Client{},
+			},
+			section: "foo",
+			key:     "bar",
+			status:  StatusNotImplemented,
+			err:     "kivik: driver does not support Config interface",
+		},
+		{
+			name: "error",
+			client: &Client{
+				driverClient: &mock.Configer{
+					DeleteConfigValueFunc: func(_ context.Context, _, _, _ string) (string, error) {
+						return "", errors.New("conf error")
+					},
+				},
+			},
+			section: "foo",
+			key:     "bar",
+			status:  StatusInternalServerError,
+			err:     "conf error",
+		},
+		{
+			name: "success",
+			client: &Client{
+				driverClient: &mock.Configer{
+					DeleteConfigValueFunc: func(_ context.Context, node, section, key string) (string, error) {
+						if node != LocalNode {
+							return "", fmt.Errorf("Unexpected node: %s", node)
+						}
+						if section != "foo" || key != "bar" {
+							return "", fmt.Errorf("Unexpected arguments: %s/%s", section, key)
+						}
+						return "old", nil
+					},
+				},
+			},
+			section:  "foo",
+			key:      "bar",
+			expected: "old",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := test.client.DeleteConfigValue(context.Background(), test.node, test.section, test.key)
+			testy.StatusError(t, test.err, test.status, err)
+			if result != test.expected {
+				t.Errorf("Unexpected result: %s", result)
+			}
+		})
+	}
+}
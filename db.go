@@ -42,16 +42,30 @@ func (db *DB) AllDocs(ctx context.Context, options ...Options) (*Rows, error) {
 	return newRows(ctx, rowsi), nil
 }
 
-// DesignDocs returns a list of all documents in the database.
+// DesignDocs returns a list of all design documents in the database.
+//
+// If the driver or server does not support the /{db}/_design_docs endpoint
+// (added in CouchDB 2.2.0), the result is emulated with an AllDocs query over
+// the "_design/" key range. Explicit startkey and endkey options override the
+// range.
 func (db *DB) DesignDocs(ctx context.Context, options ...Options) (*Rows, error) {
-	ddocer, ok := db.driverDB.(driver.DesignDocer)
-	if !ok {
-		return nil, errors.Status(StatusNotImplemented, "kivik: design doc view not supported by driver")
-	}
 	opts, err := mergeOptions(options...)
 	if err != nil {
 		return nil, err
 	}
+	rows, err := db.nativeDesignDocs(ctx, opts)
+	switch StatusCode(err) {
+	case StatusNotFound, StatusNotImplemented:
+		return db.fallbackDesignDocs(ctx, opts)
+	}
+	return rows, err
+}
+
+func (db *DB) nativeDesignDocs(ctx context.Context, opts Options) (*Rows, error) {
+	ddocer, ok := db.driverDB.(driver.DesignDocer)
+	if !ok {
+		return nil, errors.Status(StatusNotImplemented, "kivik: design doc view not supported by driver")
+	}
 	rowsi, err := ddocer.DesignDocs(ctx, opts)
 	if err != nil {
 		return nil, err
@@ -59,7 +73,22 @@ func (db *DB) DesignDocs(ctx context.Context, options ...Options) (*Rows, error)
 	return newRows(ctx, rowsi), nil
 }
 
-// LocalDocs returns a list of all documents in the database.
+func (db *DB) fallbackDesignDocs(ctx context.Context, opts Options) (*Rows, error) {
+	defaults := Options{
+		"startkey": "_design/",
+		"endkey":   "_design0",
+	}
+	if descending, _ := opts["descending"].(bool); descending || opts["descending"] == "true" {
+		defaults["startkey"], defaults["endkey"] = defaults["endkey"], defaults["startkey"]
+	}
+	return db.AllDocs(ctx, defaults, opts)
+}
+
+// LocalDocs returns a list of all local documents in the database. Local
+// documents, whose IDs are prefixed with "_local/", are not replicated and
+// are not included in AllDocs, which makes this useful for inspecting
+// replication checkpoints. As with AllDocs, startkey, endkey and limit may be
+// used to select a range.
 func (db *DB) LocalDocs(ctx context.Context, options ...Options) (*Rows, error) {
 	ldocer, ok := db.driverDB.(driver.LocalDocer)
 	if !ok {
@@ -78,7 +107,9 @@ func (db *DB) LocalDocs(ctx context.Context, options ...Options) (*Rows, error)
 
 // Query executes the specified view function from the specified design
 // document. ddoc and view may or may not be be prefixed with '_design/'
-// and '_view/' respectively. No other
+// and '_view/' respectively. View options include reduce, group, group_level,
+// key, keys, startkey, endkey and include_docs. Rows from a reduced view have
+// no document ID, so ID returns an empty string for them.
 func (db *DB) Query(ctx context.Context, ddoc, view string, options ...Options) (*Rows, error) {
 	opts, err := mergeOptions(options...)
 	if err != nil {
@@ -116,7 +147,11 @@ type Row struct {
 	// typically returned by ScanDoc.
 	Err error
 
-	// Attachments is experimental
+	// Attachments is experimental. When the driver returns the document and
+	// its attachments as a single multipart/related response, as the CouchDB
+	// driver does for Get with the `attachments=true` option, Attachments
+	// iterates over the attachments, after Body has been read. Otherwise it is
+	// nil.
 	Attachments *AttachmentsIterator
 }
 
@@ -134,8 +169,24 @@ func (r *Row) ScanDoc(dest interface{}) error {
 	return errors.WrapStatus(StatusBadResponse, json.NewDecoder(r.Body).Decode(dest))
 }
 
+// OptionIfNoneMatch is the option key with which a known document revision
+// is passed to Get, to make a conditional request. Drivers which support it
+// send the revision as the If-None-Match header.
+const OptionIfNoneMatch = "If-None-Match"
+
 // Get fetches the requested document. Any errors are deferred until the
-// row.ScanDoc call.
+// row.ScanDoc call. For CouchDB, options include rev, conflicts, revs,
+// open_revs and attachments. A request for a revision which does not exist,
+// or which has been compacted away, results in an error with status
+// StatusNotFound.
+//
+// For a conditional request, pass a known revision with the OptionIfNoneMatch
+// option. When the document is unchanged, drivers report the server's 304
+// response with status StatusNotModified, so the returned Row has no Body, and
+// IsNotModified(row.Err) returns true. Callers caching documents should treat
+// this as a cache hit, rather than a failure.
+//
+// See http://docs.couchdb.org/en/2.1.1/api/document/common.html#get--db-docid
 func (db *DB) Get(ctx context.Context, docID string, options ...Options) *Row {
 	opts, err := mergeOptions(options...)
 	if err != nil {
@@ -157,7 +208,10 @@ func (db *DB) Get(ctx context.Context, docID string, options ...Options) *Row {
 }
 
 // GetMeta returns the size and rev of the specified document. GetMeta accepts
-// the same options as the Get method.
+// the same options as the Get method. Drivers which implement
+// driver.MetaGetter may do this without fetching the document body, such as
+// with an HTTP HEAD request. A missing document results in an error with
+// status StatusNotFound.
 func (db *DB) GetMeta(ctx context.Context, docID string, options ...Options) (size int64, rev string, err error) {
 	opts, err := mergeOptions(options...)
 	if err != nil {
@@ -166,7 +220,7 @@ func (db *DB) GetMeta(ctx context.Context, docID string, options ...Options) (si
 	if r, ok := db.driverDB.(driver.MetaGetter); ok {
 		return r.GetMeta(ctx, docID, opts)
 	}
-	row := db.Get(ctx, docID, nil)
+	row := db.Get(ctx, docID, opts)
 	if row.Err != nil {
 		return 0, "", row.Err
 	}
@@ -184,7 +238,9 @@ func (db *DB) GetMeta(ctx context.Context, docID string, options ...Options) (si
 }
 
 // CreateDoc creates a new doc with an auto-generated unique ID. The generated
-// docID and new rev are returned.
+// docID and new rev are returned. With CouchDB, this is a POST to /{db}; if doc
+// contains an _id field, the server uses it instead of generating one, as per
+// CouchDB's rules.
 func (db *DB) CreateDoc(ctx context.Context, doc interface{}, options ...Options) (docID, rev string, err error) {
 	opts, err := mergeOptions(options...)
 	if err != nil {
@@ -221,41 +277,63 @@ func normalizeFromJSON(i interface{}) (interface{}, error) {
 }
 
 func extractDocID(i interface{}) (string, bool) {
+	return extractField(i, "_id")
+}
+
+func extractRev(i interface{}) (string, bool) {
+	return extractField(i, "_rev")
+}
+
+// extractField returns the non-empty string value of the top-level JSON field
+// key of i.
+func extractField(i interface{}, key string) (string, bool) {
 	if i == nil {
 		return "", false
 	}
-	var id string
+	var value string
 	var ok bool
 	switch t := i.(type) {
 	case map[string]interface{}:
-		id, ok = t["_id"].(string)
+		value, ok = t[key].(string)
 	case map[string]string:
-		id, ok = t["_id"]
+		value, ok = t[key]
 	default:
 		data, err := json.Marshal(i)
 		if err != nil {
 			return "", false
 		}
-		var result struct {
-			ID string `json:"_id"`
-		}
+		var result map[string]interface{}
 		if err := json.Unmarshal(data, &result); err != nil {
 			return "", false
 		}
-		id = result.ID
-		ok = result.ID != ""
+		value, ok = result[key].(string)
+		ok = ok && value != ""
 	}
 	if !ok {
 		return "", false
 	}
-	return id, true
+	return value, true
 }
 
+// OptionCreateOnly is the option key which, when set to true, restricts Put
+// to creating new documents.
+const OptionCreateOnly = "kivik.create_only"
+
 // Put creates a new doc or updates an existing one, with the specified docID.
 // If the document already exists, the current revision must be included in doc,
 // with JSON key '_rev', otherwise a conflict will occur. The new rev is
 // returned.
 //
+// Put never overwrites a document blindly: without a '_rev', Put only
+// succeeds if the document does not yet exist, and otherwise fails with status
+// StatusConflict, which may be tested with IsConflict. To modify a document
+// regardless of its current revision, use Update.
+//
+// To make the intent to create explicit, set the OptionCreateOnly option to
+// true. Put then fails with StatusBadAPICall if doc includes a '_rev', rather
+// than updating the document, and reports an existing document with
+// StatusConflict. The option is not passed to the driver.
+//
 // doc may be one of:
 //
 //  - An object to be marshaled to JSON. The resulting JSON structure must
@@ -263,6 +341,10 @@ func extractDocID(i interface{}) (string, bool) {
 //  - A []byte value, containing a valid JSON document
 //  - A json.RawMessage value containing a valid JSON document
 //  - An io.Reader, from which a valid JSON document may be read.
+//
+// With CouchDB, the option batch=ok may be used to have the server buffer the
+// write, trading durability for throughput. The server then responds with 202
+// (Accepted) before the document is stored, so the returned rev is empty.
 func (db *DB) Put(ctx context.Context, docID string, doc interface{}, options ...Options) (rev string, err error) {
 	if docID == "" {
 		return "", missingArg("docID")
@@ -275,9 +357,76 @@ func (db *DB) Put(ctx context.Context, docID string, doc interface{}, options ..
 	if err != nil {
 		return "", err
 	}
+	if createOnly, _ := opts[OptionCreateOnly].(bool); createOnly {
+		return db.create(ctx, docID, i, opts)
+	}
 	return db.driverDB.Put(ctx, docID, i, opts)
 }
 
+// create implements Put with the OptionCreateOnly option.
+func (db *DB) create(ctx context.Context, docID string, doc interface{}, opts Options) (string, error) {
+	if _, ok := extractRev(doc); ok {
+		return "", errors.Status(StatusBadAPICall, "kivik: _rev not permitted for create-only Put")
+	}
+	delete(opts, OptionCreateOnly)
+	rev, err := db.driverDB.Put(ctx, docID, doc, opts)
+	if IsConflict(err) {
+		return "", errors.Statusf(StatusConflict, "kivik: document %s already exists", docID)
+	}
+	return rev, err
+}
+
+// maxUpdateAttempts is the number of times Update will try to store a
+// document before giving up on repeated conflicts.
+const maxUpdateAttempts = 10
+
+// Update fetches the current revision of docID, passes it to fn for
+// modification, and stores the result, returning the new rev. If another
+// writer updates the document in the meantime, resulting in a conflict, the
+// process is repeated with a freshly-fetched copy of the document, up to ten
+// times, after which the conflict error is returned. Any error returned by fn
+// aborts the update, and is returned unaltered.
+//
+// Options are passed to Get only; fn should not modify the document's _id or
+// _rev. A rev option applies to the first attempt only, as retries must fetch
+// the latest revision.
+func (db *DB) Update(ctx context.Context, docID string, fn func(doc map[string]interface{}) error, options ...Options) (newRev string, err error) {
+	if docID == "" {
+		return "", missingArg("docID")
+	}
+	opts, err := mergeOptions(options...)
+	if err != nil {
+		return "", err
+	}
+	retryOpts := opts
+	if _, ok := opts["rev"]; ok {
+		retryOpts = make(Options, len(opts))
+		for k, v := range opts {
+			if k != "rev" {
+				retryOpts[k] = v
+			}
+		}
+	}
+	for attempt := 0; attempt < maxUpdateAttempts; attempt++ {
+		getOpts := opts
+		if attempt > 0 {
+			getOpts = retryOpts
+		}
+		var doc map[string]interface{}
+		if err = db.Get(ctx, docID, getOpts).ScanDoc(&doc); err != nil {
+			return "", err
+		}
+		if err = fn(doc); err != nil {
+			return "", err
+		}
+		newRev, err = db.Put(ctx, docID, doc)
+		if !IsConflict(err) {
+			return newRev, err
+		}
+	}
+	return "", err
+}
+
 // Delete marks the specified document as deleted.
 func (db *DB) Delete(ctx context.Context, docID, rev string, options ...Options) (newRev string, err error) {
 	if docID == "" {
@@ -290,7 +439,11 @@ func (db *DB) Delete(ctx context.Context, docID, rev string, options ...Options)
 	return db.driverDB.Delete(ctx, docID, rev, opts)
 }
 
-// Flush requests a flush of disk cache to disk or other permanent storage.
+// Flush requests a flush of disk cache to disk or other permanent storage,
+// such as writes buffered by batch=ok, or by CouchDB's delayed commits.
+//
+// CouchDB 3.0 and later no longer support this endpoint; drivers should
+// report this as an error with status StatusNotImplemented.
 //
 // See http://docs.couchdb.org/en/2.0.0/api/database/compact.html#db-ensure-full-commit
 func (db *DB) Flush(ctx context.Context) error {
@@ -369,13 +522,25 @@ func driverStats2kivikStats(i *driver.DBStats) *DBStats {
 	}
 }
 
-// Compact begins compaction of the database. Check the CompactRunning field
-// returned by Info() to see if the compaction has completed.
+// Compact begins compaction of the database. Compaction runs in the
+// background on the server; call CompactRunning to see if the compaction has
+// completed.
 // See http://docs.couchdb.org/en/2.0.0/api/database/compact.html#db-compact
 func (db *DB) Compact(ctx context.Context) error {
 	return db.driverDB.Compact(ctx)
 }
 
+// CompactRunning returns true if compaction of the database, or any of its
+// views, is currently running. This is a convenience wrapper around Stats,
+// suitable for polling for the completion of Compact or CompactView.
+func (db *DB) CompactRunning(ctx context.Context) (bool, error) {
+	stats, err := db.Stats(ctx)
+	if err != nil {
+		return false, err
+	}
+	return stats.CompactRunning, nil
+}
+
 // CompactView compats the view indexes associated with the specified design
 // document.
 // See http://docs.couchdb.org/en/2.0.0/api/database/compact.html#db-compact-design-doc
@@ -400,6 +565,7 @@ func (db *DB) Security(ctx context.Context) (*Security, error) {
 	return &Security{
 		Admins:  Members(s.Admins),
 		Members: Members(s.Members),
+		Extra:   s.Extra,
 	}, err
 }
 
@@ -412,6 +578,7 @@ func (db *DB) SetSecurity(ctx context.Context, security *Security) error {
 	sec := &driver.Security{
 		Admins:  driver.Members(security.Admins),
 		Members: driver.Members(security.Members),
+		Extra:   security.Extra,
 	}
 	return db.driverDB.SetSecurity(ctx, sec)
 }
@@ -447,7 +614,8 @@ func (db *DB) Copy(ctx context.Context, targetID, sourceID string, options ...Op
 }
 
 // PutAttachment uploads the supplied content as an attachment to the specified
-// document.
+// document, returning the document's new revision. att.Content is read by the
+// driver as the upload proceeds, so the attachment need not be held in memory.
 func (db *DB) PutAttachment(ctx context.Context, docID, rev string, att *Attachment, options ...Options) (newRev string, err error) {
 	if docID == "" {
 		return "", missingArg("docID")
@@ -463,7 +631,11 @@ func (db *DB) PutAttachment(ctx context.Context, docID, rev string, att *Attachm
 	return db.driverDB.PutAttachment(ctx, docID, rev, &a, opts)
 }
 
-// GetAttachment returns a file attachment associated with the document.
+// GetAttachment returns a file attachment associated with the document. The
+// attachment's Content is streamed directly from the backend, where supported,
+// rather than being buffered in memory. It is the caller's responsibility to
+// close Content. To check the content against the attachment's digest as it is
+// read, call VerifyDigest on the returned Attachment.
 func (db *DB) GetAttachment(ctx context.Context, docID, rev, filename string, options ...Options) (*Attachment, error) {
 	if docID == "" {
 		return nil, missingArg("docID")
@@ -527,8 +699,40 @@ func (db *DB) GetAttachmentMeta(ctx context.Context, docID, rev, filename string
 	return att, nil
 }
 
-// DeleteAttachment delets an attachment from a document, returning the
-// document's new revision.
+// GetAttachmentRange returns bytes start through end, inclusive, of a file
+// attachment associated with the document. This is useful for resuming
+// interrupted downloads, or for partial reads of large attachments. The Size
+// of the returned attachment is the size of the requested range. It is the
+// caller's responsibility to close Content.
+func (db *DB) GetAttachmentRange(ctx context.Context, docID, rev, filename string, start, end int64, options ...Options) (*Attachment, error) {
+	if docID == "" {
+		return nil, missingArg("docID")
+	}
+	if filename == "" {
+		return nil, missingArg("filename")
+	}
+	if start < 0 || end < start {
+		return nil, errors.Statusf(StatusBadAPICall, "kivik: invalid range %d-%d", start, end)
+	}
+	ranger, ok := db.driverDB.(driver.AttachmentRangeGetter)
+	if !ok {
+		return nil, errors.Status(StatusNotImplemented, "kivik: attachment ranges not supported by driver")
+	}
+	opts, err := mergeOptions(options...)
+	if err != nil {
+		return nil, err
+	}
+	att, err := ranger.GetAttachmentRange(ctx, docID, rev, filename, start, end, opts)
+	if err != nil {
+		return nil, err
+	}
+	a := Attachment(*att)
+	return &a, nil
+}
+
+// DeleteAttachment deletes an attachment from a document, returning the
+// document's new revision. If the attachment does not exist, an error with
+// status StatusNotFound is returned.
 func (db *DB) DeleteAttachment(ctx context.Context, docID, rev, filename string, options ...Options) (newRev string, err error) {
 	if docID == "" {
 		return "", missingArg("docID")
@@ -572,3 +776,63 @@ func (db *DB) Purge(ctx context.Context, docRevMap map[string][]string) (*PurgeR
 	}
 	return nil, errors.Status(StatusNotImplemented, "kivik: purge not supported by driver")
 }
+
+// RevDiff represents the revisions of a single document, which are missing
+// from the database.
+type RevDiff struct {
+	// Missing is the list of requested revisions not stored in the database.
+	Missing []string `json:"missing,omitempty"`
+	// PossibleAncestors is the list of stored revisions which may be
+	// ancestors of the missing revisions.
+	PossibleAncestors []string `json:"possible_ancestors,omitempty"`
+}
+
+// RevsDiff returns, for each document ID in revMap, the subset of the
+// requested revisions which are not stored in the database, along with any
+// possible ancestors. Documents with no missing revisions are omitted from
+// the result. This is the basis of the replication protocol.
+//
+// RevsDiff expects as input a map with document ID as key, and slice of
+// revisions as value.
+func (db *DB) RevsDiff(ctx context.Context, revMap map[string][]string) (map[string]RevDiff, error) {
+	differ, ok := db.driverDB.(driver.RevsDiffer)
+	if !ok {
+		return nil, errors.Status(StatusNotImplemented, "kivik: _revs_diff not supported by driver")
+	}
+	diffs, err := differ.RevsDiff(ctx, revMap)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]RevDiff, len(diffs))
+	for docID, diff := range diffs {
+		result[docID] = RevDiff(diff)
+	}
+	return result, nil
+}
+
+var revsLimitNotImplemented = errors.Status(StatusNotImplemented, "kivik: _revs_limit not supported by driver")
+
+// RevsLimit returns the maximum number of document revisions that will be
+// tracked by the database.
+// See http://docs.couchdb.org/en/2.1.1/api/database/misc.html#get--db-_revs_limit
+func (db *DB) RevsLimit(ctx context.Context) (int, error) {
+	limiter, ok := db.driverDB.(driver.RevsLimiter)
+	if !ok {
+		return 0, revsLimitNotImplemented
+	}
+	return limiter.RevsLimit(ctx)
+}
+
+// SetRevsLimit sets the maximum number of document revisions that will be
+// tracked by the database. limit must be positive.
+// See http://docs.couchdb.org/en/2.1.1/api/database/misc.html#put--db-_revs_limit
+func (db *DB) SetRevsLimit(ctx context.Context, limit int) error {
+	if limit <= 0 {
+		return errors.Statusf(StatusBadAPICall, "kivik: invalid revs limit %d", limit)
+	}
+	limiter, ok := db.driverDB.(driver.RevsLimiter)
+	if !ok {
+		return revsLimitNotImplemented
+	}
+	return limiter.SetRevsLimit(ctx, limit)
+}
@@ -137,10 +137,104 @@ func TestDesignDocs(t *testing.T) {
 			},
 		},
 		{
-			name:   "not supported",
-			db:     &DB{driverDB: &mock.DB{}},
-			status: StatusNotImplemented,
-			err:    "kivik: design doc view not supported by driver",
+			name: "AllDocs fallback",
+			db: &DB{
+				driverDB: &mock.DB{
+					AllDocsFunc: func(_ context.Context, opts map[string]interface{}) (driver.Rows, error) {
+						expected := map[string]interface{}{
+							"startkey":     "_design/",
+							"endkey":       "_design0",
+							"include_docs": true,
+						}
+						if d := diff.Interface(expected, opts); d != nil {
+							return nil, fmt.Errorf("Unexpected options: %s", d)
+						}
+						return &mock.Rows{ID: "a"}, nil
+					},
+				},
+			},
+			options: Options{"include_docs": true},
+			expected: &Rows{
+				iter: &iter{
+					feed: &rowsIterator{
+						Rows: &mock.Rows{ID: "a"},
+					},
+					curVal: &driver.Row{},
+				},
+				rowsi: &mock.Rows{ID: "a"},
+			},
+		},
+		{
+			name: "fallback due to old server",
+			db: &DB{
+				driverDB: &mock.DesignDocer{
+					DB: &mock.DB{
+						AllDocsFunc: func(_ context.Context, opts map[string]interface{}) (driver.Rows, error) {
+							expected := map[string]interface{}{
+								"startkey": "_design/",
+								"endkey":   "_design0",
+							}
+							if d := diff.Interface(expected, opts); d != nil {
+								return nil, fmt.Errorf("Unexpected options: %s", d)
+							}
+							return &mock.Rows{ID: "a"}, nil
+						},
+					},
+					DesignDocsFunc: func(_ context.Context, _ map[string]interface{}) (driver.Rows, error) {
+						return nil, errors.Status(StatusNotFound, "not found")
+					},
+				},
+			},
+			expected: &Rows{
+				iter: &iter{
+					feed: &rowsIterator{
+						Rows: &mock.Rows{ID: "a"},
+					},
+					curVal: &driver.Row{},
+				},
+				rowsi: &mock.Rows{ID: "a"},
+			},
+		},
+		{
+			name: "AllDocs fallback descending",
+			db: &DB{
+				driverDB: &mock.DB{
+					AllDocsFunc: func(_ context.Context, opts map[string]interface{}) (driver.Rows, error) {
+						expected := map[string]interface{}{
+							"startkey":   "_design0",
+							"endkey":     "_design/",
+							"descending": true,
+						}
+						if d := diff.Interface(expected, opts); d != nil {
+							return nil, fmt.Errorf("Unexpected options: %s", d)
+						}
+						return nil, errors.New("all docs error")
+					},
+				},
+			},
+			options: Options{"descending": true},
+			status:  StatusInternalServerError,
+			err:     "all docs error",
+		},
+		{
+			name: "AllDocs fallback with custom range",
+			db: &DB{
+				driverDB: &mock.DB{
+					AllDocsFunc: func(_ context.Context, opts map[string]interface{}) (driver.Rows, error) {
+						expected := map[string]interface{}{
+							"startkey": "_design/m",
+							"endkey":   "_design0",
+						}
+						if d := diff.Interface(expected, opts); d != nil {
+							return nil, fmt.Errorf("Unexpected options: %s", d)
+						}
+						return nil, errors.New("all docs error")
+					},
+				},
+			},
+			options: Options{"startkey": "_design/m"},
+			status:  StatusInternalServerError,
+			err:     "all docs error",
 		},
 	}
 	for _, test := range tests {
@@ -378,6 +472,29 @@ func TestGet(t *testing.T) {
 	}
 }
 
+func TestGetNotModified(t *testing.T) {
+	db := &DB{
+		driverDB: &mock.DB{
+			GetFunc: func(_ context.Context, _ string, opts map[string]interface{}) (*driver.Document, error) {
+				if opts[OptionIfNoneMatch] != "1-xxx" {
+					return nil, fmt.Errorf("Unexpected options: %v", opts)
+				}
+				return nil, errors.Status(StatusNotModified, "Not Modified")
+			},
+		},
+	}
+	row := db.Get(context.Background(), "foo", Options{OptionIfNoneMatch: "1-xxx"})
+	if !IsNotModified(row.Err) {
+		t.Errorf("Expected not modified, got: %v", row.Err)
+	}
+	if row.Body != nil {
+		t.Error("Expected no body")
+	}
+	var doc interface{}
+	if err := row.ScanDoc(&doc); !IsNotModified(err) {
+		t.Errorf("Expected ScanDoc to report not modified, got: %v", err)
+	}
+}
 func TestFlush(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -512,6 +629,60 @@ func TestCompact(t *testing.T) {
 	testy.StatusError(t, expected, StatusBadRequest, err)
 }
 
+func TestCompactRunning(t *testing.T) {
+	tests := []struct {
+		name     string
+		db       *DB
+		expected bool
+		status   int
+		err      string
+	}{
+		{
+			name: "stats error",
+			db: &DB{
+				driverDB: &mock.DB{
+					StatsFunc: func(_ context.Context) (*driver.DBStats, error) {
+						return nil, errors.Status(StatusBadResponse, "stats error")
+					},
+				},
+			},
+			status: StatusBadResponse,
+			err:    "stats error",
+		},
+		{
+			name: "running",
+			db: &DB{
+				driverDB: &mock.DB{
+					StatsFunc: func(_ context.Context) (*driver.DBStats, error) {
+						return &driver.DBStats{CompactRunning: true}, nil
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "not running",
+			db: &DB{
+				driverDB: &mock.DB{
+					StatsFunc: func(_ context.Context) (*driver.DBStats, error) {
+						return &driver.DBStats{}, nil
+					},
+				},
+			},
+			expected: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := test.db.CompactRunning(context.Background())
+			testy.StatusError(t, test.err, test.status, err)
+			if result != test.expected {
+				t.Errorf("Unexpected result: %v", result)
+			}
+		})
+	}
+}
+
 func TestCompactView(t *testing.T) {
 	expectedDDocID := "foo"
 	expected := "compact view error"
@@ -591,6 +762,23 @@ func TestSecurity(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "extra fields",
+			db: &DB{
+				driverDB: &mock.DB{
+					SecurityFunc: func(_ context.Context) (*driver.Security, error) {
+						return &driver.Security{
+							Admins: driver.Members{Names: []string{"a"}},
+							Extra:  map[string]interface{}{"cloudant": "foo"},
+						}, nil
+					},
+				},
+			},
+			expected: &Security{
+				Admins: Members{Names: []string{"a"}},
+				Extra:  map[string]interface{}{"cloudant": "foo"},
+			},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -662,6 +850,27 @@ func TestSetSecurity(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "extra fields",
+			db: &DB{
+				driverDB: &mock.DB{
+					SetSecurityFunc: func(_ context.Context, security *driver.Security) error {
+						expectedSecurity := &driver.Security{
+							Members: driver.Members{Roles: []string{"d"}},
+							Extra:   map[string]interface{}{"cloudant": "foo"},
+						}
+						if d := diff.Interface(expectedSecurity, security); d != nil {
+							return fmt.Errorf("Unexpected security:\n%s", d)
+						}
+						return nil
+					},
+				},
+			},
+			security: &Security{
+				Members: Members{Roles: []string{"d"}},
+				Extra:   map[string]interface{}{"cloudant": "foo"},
+			},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -774,6 +983,27 @@ func TestGetMeta(t *testing.T) { // nolint: gocyclo
 			size:  16,
 			rev:   "1-xxx",
 		},
+		{
+			name: "non-meta getter with options",
+			db: &DB{
+				driverDB: &mock.DB{
+					GetFunc: func(_ context.Context, _ string, opts map[string]interface{}) (*driver.Document, error) {
+						if d := diff.Interface(testOptions, opts); d != nil {
+							return nil, fmt.Errorf("Unexpected options:\n%s", d)
+						}
+						return &driver.Document{
+							ContentLength: 16,
+							Rev:           "1-xxx",
+							Body:          body(`{"_rev":"1-xxx"}`),
+						}, nil
+					},
+				},
+			},
+			docID:   "foo",
+			options: testOptions,
+			size:    16,
+			rev:     "1-xxx",
+		},
 		{
 			name: "non-meta getter success without rev, invalid json",
 			db: &DB{
@@ -1165,6 +1395,44 @@ func TestPut(t *testing.T) {
 	}
 }
 
+func TestPutCreateOnly(t *testing.T) {
+	db := &DB{
+		driverDB: &mock.DB{
+			PutFunc: func(_ context.Context, docID string, _ interface{}, opts map[string]interface{}) (string, error) {
+				if _, ok := opts[OptionCreateOnly]; ok {
+					return "", fmt.Errorf("Unexpected %s option passed to driver", OptionCreateOnly)
+				}
+				if docID == "exists" {
+					return "", errors.Status(StatusConflict, "Document update conflict.")
+				}
+				return "1-xxx", nil
+			},
+		},
+	}
+	createOnly := Options{OptionCreateOnly: true}
+	t.Run("new", func(t *testing.T) {
+		rev, err := db.Put(context.Background(), "foo", map[string]string{"foo": "bar"}, createOnly)
+		testy.Error(t, "", err)
+		if rev != "1-xxx" {
+			t.Errorf("Unexpected new rev: %s", rev)
+		}
+	})
+	t.Run("exists", func(t *testing.T) {
+		_, err := db.Put(context.Background(), "exists", map[string]string{"foo": "bar"}, createOnly)
+		if !IsConflict(err) {
+			t.Errorf("Expected IsConflict to be true")
+		}
+		testy.StatusError(t, "kivik: document exists already exists", StatusConflict, err)
+	})
+	t.Run("rev", func(t *testing.T) {
+		doc := struct {
+			Rev string `json:"_rev"`
+		}{Rev: "1-xxx"}
+		_, err := db.Put(context.Background(), "foo", doc, createOnly)
+		testy.StatusError(t, "kivik: _rev not permitted for create-only Put", StatusBadAPICall, err)
+	})
+}
+
 func TestExtractDocID(t *testing.T) {
 	type ediTest struct {
 		name     string
@@ -1310,13 +1578,176 @@ func TestCreateDoc(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			docID, rev, err := test.db.CreateDoc(context.Background(), test.doc, test.options)
 			testy.StatusError(t, test.err, test.status, err)
-			if docID != test.docID || test.rev != test.rev {
+			if docID != test.docID || rev != test.rev {
 				t.Errorf("Unexpected result: %s / %s", docID, rev)
 			}
 		})
 	}
 }
 
+func TestUpdate(t *testing.T) {
+	getDoc := func(_ context.Context, _ string, _ map[string]interface{}) (*driver.Document, error) {
+		return &driver.Document{
+			Rev:  "1-xxx",
+			Body: body(`{"_id":"foo","_rev":"1-xxx","count":1}`),
+		}, nil
+	}
+	tests := []struct {
+		name     string
+		db       *DB
+		docID    string
+		fn       func(map[string]interface{}) error
+		options  Options
+		attempts int
+		rev      string
+		status   int
+		err      string
+	}{
+		{
+			name:   "no docID",
+			status: StatusBadRequest,
+			err:    "kivik: docID required",
+		},
+		{
+			name: "get error",
+			db: &DB{
+				driverDB: &mock.DB{
+					GetFunc: func(_ context.Context, _ string, _ map[string]interface{}) (*driver.Document, error) {
+						return nil, errors.Status(StatusNotFound, "missing")
+					},
+				},
+			},
+			docID:  "foo",
+			status: StatusNotFound,
+			err:    "missing",
+		},
+		{
+			name: "callback error",
+			db: &DB{
+				driverDB: &mock.DB{
+					GetFunc: getDoc,
+				},
+			},
+			docID: "foo",
+			fn: func(_ map[string]interface{}) error {
+				return errors.Status(StatusBadAPICall, "callback error")
+			},
+			attempts: 1,
+			status:   StatusBadAPICall,
+			err:      "callback error",
+		},
+		{
+			name: "success",
+			db: &DB{
+				driverDB: &mock.DB{
+					GetFunc: getDoc,
+					PutFunc: func(_ context.Context, docID string, doc interface{}, _ map[string]interface{}) (string, error) {
+						expected := map[string]interface{}{"_id": "foo", "_rev": "1-xxx", "count": 2.0}
+						if d := diff.AsJSON(expected, doc); d != nil {
+							return "", fmt.Errorf("Unexpected doc:\n%s", d)
+						}
+						return "2-xxx", nil
+					},
+				},
+			},
+			docID: "foo",
+			fn: func(doc map[string]interface{}) error {
+				doc["count"] = doc["count"].(float64) + 1
+				return nil
+			},
+			attempts: 1,
+			rev:      "2-xxx",
+		},
+		{
+			name: "conflict then success",
+			db: func() *DB {
+				var puts int
+				return &DB{
+					driverDB: &mock.DB{
+						GetFunc: getDoc,
+						PutFunc: func(_ context.Context, _ string, _ interface{}, _ map[string]interface{}) (string, error) {
+							puts++
+							if puts < 3 {
+								return "", errors.Status(StatusConflict, "conflict")
+							}
+							return "2-xxx", nil
+						},
+					},
+				}
+			}(),
+			docID:    "foo",
+			attempts: 3,
+			rev:      "2-xxx",
+		},
+		{
+			name: "retry drops rev",
+			db: func() *DB {
+				var gets int
+				return &DB{
+					driverDB: &mock.DB{
+						GetFunc: func(ctx context.Context, docID string, opts map[string]interface{}) (*driver.Document, error) {
+							gets++
+							expected := map[string]interface{}{"rev": "1-xxx", "conflicts": true}
+							if gets > 1 {
+								expected = map[string]interface{}{"conflicts": true}
+							}
+							if d := diff.Interface(expected, opts); d != nil {
+								return nil, fmt.Errorf("Unexpected options on attempt %d:\n%s", gets, d)
+							}
+							return getDoc(ctx, docID, opts)
+						},
+						PutFunc: func(_ context.Context, _ string, _ interface{}, _ map[string]interface{}) (string, error) {
+							if gets < 2 {
+								return "", errors.Status(StatusConflict, "conflict")
+							}
+							return "3-xxx", nil
+						},
+					},
+				}
+			}(),
+			docID:    "foo",
+			options:  Options{"rev": "1-xxx", "conflicts": true},
+			attempts: 2,
+			rev:      "3-xxx",
+		},
+		{
+			name: "too many conflicts",
+			db: &DB{
+				driverDB: &mock.DB{
+					GetFunc: getDoc,
+					PutFunc: func(_ context.Context, _ string, _ interface{}, _ map[string]interface{}) (string, error) {
+						return "", errors.Status(StatusConflict, "conflict")
+					},
+				},
+			},
+			docID:    "foo",
+			attempts: maxUpdateAttempts,
+			status:   StatusConflict,
+			err:      "conflict",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var attempts int
+			fn := func(doc map[string]interface{}) error {
+				attempts++
+				if test.fn != nil {
+					return test.fn(doc)
+				}
+				return nil
+			}
+			rev, err := test.db.Update(context.Background(), test.docID, fn, test.options)
+			if attempts != test.attempts {
+				t.Errorf("Expected %d attempts, got %d", test.attempts, attempts)
+			}
+			testy.StatusError(t, test.err, test.status, err)
+			if rev != test.rev {
+				t.Errorf("Unexpected rev: %s", rev)
+			}
+		})
+	}
+}
+
 func TestDelete(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -1797,6 +2228,136 @@ func TestGetAttachmentMeta(t *testing.T) { // nolint: gocyclo
 	}
 }
 
+func TestGetAttachmentRange(t *testing.T) {
+	tests := []struct {
+		name                 string
+		db                   *DB
+		docID, rev, filename string
+		start, end           int64
+		options              Options
+
+		content  string
+		expected *Attachment
+		status   int
+		err      string
+	}{
+		{
+			name:   "no docID",
+			status: StatusBadRequest,
+			err:    "kivik: docID required",
+		},
+		{
+			name:   "no filename",
+			docID:  "foo",
+			status: StatusBadRequest,
+			err:    "kivik: filename required",
+		},
+		{
+			name:     "negative start",
+			docID:    "foo",
+			filename: "foo.txt",
+			start:    -1,
+			end:      5,
+			status:   StatusBadAPICall,
+			err:      "kivik: invalid range -1-5",
+		},
+		{
+			name:     "end before start",
+			docID:    "foo",
+			filename: "foo.txt",
+			start:    5,
+			end:      4,
+			status:   StatusBadAPICall,
+			err:      "kivik: invalid range 5-4",
+		},
+		{
+			name: "non-AttachmentRangeGetter",
+			db: &DB{
+				driverDB: &mock.DB{},
+			},
+			docID:    "foo",
+			filename: "foo.txt",
+			status:   StatusNotImplemented,
+			err:      "kivik: attachment ranges not supported by driver",
+		},
+		{
+			name: "error",
+			db: &DB{
+				driverDB: &mock.AttachmentRangeGetter{
+					GetAttachmentRangeFunc: func(_ context.Context, _, _, _ string, _, _ int64, _ map[string]interface{}) (*driver.Attachment, error) {
+						return nil, errors.New("fail")
+					},
+				},
+			},
+			docID:    "foo",
+			filename: "foo.txt",
+			status:   500,
+			err:      "fail",
+		},
+		{
+			name: "success",
+			db: &DB{
+				driverDB: &mock.AttachmentRangeGetter{
+					GetAttachmentRangeFunc: func(_ context.Context, docID, rev, filename string, start, end int64, opts map[string]interface{}) (*driver.Attachment, error) {
+						expectedDocID, expectedRev, expectedFilename := "foo", "1-xxx", "foo.txt"
+						if docID != expectedDocID {
+							return nil, fmt.Errorf("Unexpected docID: %s", docID)
+						}
+						if rev != expectedRev {
+							return nil, fmt.Errorf("Unexpected rev: %s", rev)
+						}
+						if filename != expectedFilename {
+							return nil, fmt.Errorf("Unexpected filename: %s", filename)
+						}
+						if start != 1 || end != 2 {
+							return nil, fmt.Errorf("Unexpected range: %d-%d", start, end)
+						}
+						if d := diff.Interface(testOptions, opts); d != nil {
+							return nil, fmt.Errorf("Unexpected options:\n%s", d)
+						}
+						return &driver.Attachment{
+							Filename:    "foo.txt",
+							ContentType: "text/plain",
+							Size:        2,
+							Content:     body("es"),
+						}, nil
+					},
+				},
+			},
+			docID:    "foo",
+			rev:      "1-xxx",
+			filename: "foo.txt",
+			start:    1,
+			end:      2,
+			options:  testOptions,
+			content:  "es",
+			expected: &Attachment{
+				Filename:    "foo.txt",
+				ContentType: "text/plain",
+				Size:        2,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := test.db.GetAttachmentRange(context.Background(), test.docID, test.rev, test.filename, test.start, test.end, test.options)
+			testy.StatusError(t, test.err, test.status, err)
+			content, err := ioutil.ReadAll(result.Content)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if d := diff.Text(test.content, string(content)); d != nil {
+				t.Errorf("Unexpected content:\n%s", d)
+			}
+			_ = result.Content.Close()
+			result.Content = nil
+			if d := diff.Interface(test.expected, result); d != nil {
+				t.Error(d)
+			}
+		})
+	}
+}
+
 func TestPurge(t *testing.T) {
 	type purgeTest struct {
 		name   string
@@ -1877,3 +2438,165 @@ func TestPurge(t *testing.T) {
 		})
 	}
 }
+
+func TestRevsDiff(t *testing.T) {
+	revMap := map[string][]string{
+		"foo": {"1-abc", "2-xyz"},
+		"bar": {"1-abc"},
+	}
+	tests := []struct {
+		name     string
+		db       *DB
+		revMap   map[string][]string
+		expected map[string]RevDiff
+		status   int
+		err      string
+	}{
+		{
+			name:   "non-RevsDiffer",
+			db:     &DB{driverDB: &mock.DB{}},
+			status: StatusNotImplemented,
+			err:    "kivik: _revs_diff not supported by driver",
+		},
+		{
+			name: "db error",
+			db: &DB{
+				driverDB: &mock.RevsDiffer{
+					RevsDiffFunc: func(_ context.Context, _ map[string][]string) (map[string]driver.RevDiff, error) {
+						return nil, errors.Status(StatusBadRequest, "bad request")
+					},
+				},
+			},
+			status: StatusBadRequest,
+			err:    "bad request",
+		},
+		{
+			name: "success",
+			db: &DB{
+				driverDB: &mock.RevsDiffer{
+					RevsDiffFunc: func(_ context.Context, rm map[string][]string) (map[string]driver.RevDiff, error) {
+						if d := diff.Interface(revMap, rm); d != nil {
+							return nil, errors.Errorf("Unexpected revmap: %s", d)
+						}
+						return map[string]driver.RevDiff{
+							"foo": {Missing: []string{"2-xyz"}, PossibleAncestors: []string{"1-abc"}},
+						}, nil
+					},
+				},
+			},
+			revMap: revMap,
+			expected: map[string]RevDiff{
+				"foo": {Missing: []string{"2-xyz"}, PossibleAncestors: []string{"1-abc"}},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := test.db.RevsDiff(context.Background(), test.revMap)
+			testy.StatusError(t, test.err, test.status, err)
+			if d := diff.Interface(test.expected, result); d != nil {
+				t.Error(d)
+			}
+		})
+	}
+}
+
+func TestRevsLimit(t *testing.T) {
+	tests := []struct {
+		name     string
+		db       *DB
+		expected int
+		status   int
+		err      string
+	}{
+		{
+			name:   "non-RevsLimiter",
+			db:     &DB{driverDB: &mock.DB{}},
+			status: StatusNotImplemented,
+			err:    "kivik: _revs_limit not supported by driver",
+		},
+		{
+			name: "db error",
+			db: &DB{
+				driverDB: &mock.RevsLimiter{
+					RevsLimitFunc: func(_ context.Context) (int, error) {
+						return 0, errors.New("db error")
+					},
+				},
+			},
+			status: StatusInternalServerError,
+			err:    "db error",
+		},
+		{
+			name: "success",
+			db: &DB{
+				driverDB: &mock.RevsLimiter{
+					RevsLimitFunc: func(_ context.Context) (int, error) {
+						return 1000, nil
+					},
+				},
+			},
+			expected: 1000,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := test.db.RevsLimit(context.Background())
+			testy.StatusError(t, test.err, test.status, err)
+			if result != test.expected {
+				t.Errorf("Unexpected result: %d", result)
+			}
+		})
+	}
+}
+
+func TestSetRevsLimit(t *testing.T) {
+	tests := []struct {
+		name   string
+		db     *DB
+		limit  int
+		status int
+		err    string
+	}{
+		{
+			name:   "zero limit",
+			db:     &DB{driverDB: &mock.RevsLimiter{}},
+			status: StatusBadAPICall,
+			err:    "kivik: invalid revs limit 0",
+		},
+		{
+			name:   "negative limit",
+			db:     &DB{driverDB: &mock.RevsLimiter{}},
+			limit:  -5,
+			status: StatusBadAPICall,
+			err:    "kivik: invalid revs limit -5",
+		},
+		{
+			name:   "non-RevsLimiter",
+			db:     &DB{driverDB: &mock.DB{}},
+			limit:  10,
+			status: StatusNotImplemented,
+			err:    "kivik: _revs_limit not supported by driver",
+		},
+		{
+			name: "success",
+			db: &DB{
+				driverDB: &mock.RevsLimiter{
+					SetRevsLimitFunc: func(_ context.Context, limit int) error {
+						if limit != 10 {
+							return errors.Errorf("Unexpected limit: %d", limit)
+						}
+						return nil
+					},
+				},
+			},
+			limit: 10,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.db.SetRevsLimit(context.Background(), test.limit)
+			testy.StatusError(t, test.err, test.status, err)
+		})
+	}
+}
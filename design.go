@@ -0,0 +1,236 @@
+package kivik
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/go-kivik/kivik/driver"
+	"github.com/go-kivik/kivik/errors"
+)
+
+// Show renders the document docID through the show function named show, in
+// the design document ddoc, and returns the server's content type and the
+// response body. ddoc and show may or may not be prefixed with '_design/' and
+// '_show/' respectively. docID may be empty, in which case the show function
+// is called with a null document. The body is streamed, and the caller is
+// responsible for closing it.
+// See http://docs.couchdb.org/en/2.1.1/api/ddoc/render.html#get--db-_design-ddoc-_show-func
+func (db *DB) Show(ctx context.Context, ddoc, show, docID string, options ...Options) (contentType string, body io.ReadCloser, err error) {
+	if ddoc == "" {
+		return "", nil, missingArg("ddoc")
+	}
+	if show == "" {
+		return "", nil, missingArg("show")
+	}
+	shower, ok := db.driverDB.(driver.Shower)
+	if !ok {
+		return "", nil, errors.Status(StatusNotImplemented, "kivik: show functions not supported by driver")
+	}
+	opts, err := mergeOptions(options...)
+	if err != nil {
+		return "", nil, err
+	}
+	ddoc = strings.TrimPrefix(ddoc, "_design/")
+	show = strings.TrimPrefix(show, "_show/")
+	return shower.Show(ctx, ddoc, show, docID, opts)
+}
+
+// List renders the view through the list function named list, in the design
+// document ddoc, and returns the server's content type and the response body.
+// ddoc and list may or may not be prefixed with '_design/' and '_list/'
+// respectively. To use a view from another design document, pass view as
+// "other-ddoc/view". View options, such as startkey, endkey and group, apply
+// to the view. The body is streamed, and the caller is responsible for closing
+// it.
+// See http://docs.couchdb.org/en/2.1.1/api/ddoc/render.html#get--db-_design-ddoc-_list-func-view
+func (db *DB) List(ctx context.Context, ddoc, list, view string, options ...Options) (contentType string, body io.ReadCloser, err error) {
+	if ddoc == "" {
+		return "", nil, missingArg("ddoc")
+	}
+	if list == "" {
+		return "", nil, missingArg("list")
+	}
+	if view == "" {
+		return "", nil, missingArg("view")
+	}
+	lister, ok := db.driverDB.(driver.Lister)
+	if !ok {
+		return "", nil, errors.Status(StatusNotImplemented, "kivik: list functions not supported by driver")
+	}
+	opts, err := mergeOptions(options...)
+	if err != nil {
+		return "", nil, err
+	}
+	ddoc = strings.TrimPrefix(ddoc, "_design/")
+	list = strings.TrimPrefix(list, "_list/")
+	return lister.List(ctx, ddoc, list, view, opts)
+}
+
+// UpdateFunc sends body, of the given content type, to the update function
+// named update, in the design document ddoc. ddoc and update may or may not be
+// prefixed with '_design/' and '_update/' respectively. If docID is empty,
+// the update function is called without a document, which typically creates
+// one. The new revision, if the update function saved a document, is
+// returned as newRev, along with the response body.
+// See http://docs.couchdb.org/en/2.1.1/api/ddoc/render.html#put--db-_design-ddoc-_update-func-docid
+func (db *DB) UpdateFunc(ctx context.Context, ddoc, update, docID string, body io.Reader, contentType string, options ...Options) (newRev string, respBody []byte, err error) {
+	if ddoc == "" {
+		return "", nil, missingArg("ddoc")
+	}
+	if update == "" {
+		return "", nil, missingArg("update")
+	}
+	updater, ok := db.driverDB.(driver.UpdateFuncer)
+	if !ok {
+		return "", nil, errors.Status(StatusNotImplemented, "kivik: update functions not supported by driver")
+	}
+	opts, err := mergeOptions(options...)
+	if err != nil {
+		return "", nil, err
+	}
+	ddoc = strings.TrimPrefix(ddoc, "_design/")
+	update = strings.TrimPrefix(update, "_update/")
+	return updater.UpdateFunc(ctx, ddoc, update, docID, body, contentType, opts)
+}
+
+// DesignInfo represents the view index information of a design document.
+type DesignInfo struct {
+	// Name is the name of the design document, without the '_design/' prefix.
+	Name string
+	// Signature is the MD5 signature of the view index.
+	Signature string
+	// UpdateSeq is the update sequence of the database up to which the index
+	// is built.
+	UpdateSeq string
+	// DataSize is the size of the live data in the index, in bytes.
+	DataSize int64
+	// DiskSize is the size of the index on disk, in bytes.
+	DiskSize int64
+	// CompactRunning is true if the index is being compacted.
+	CompactRunning bool
+	// UpdaterRunning is true if the index is being updated.
+	UpdaterRunning bool
+	// WaitingClients is the number of clients waiting for the index.
+	WaitingClients int64
+}
+
+// DesignInfo returns the view index information for the design document
+// ddoc, which may or may not be prefixed with '_design/'. A DiskSize much
+// larger than DataSize indicates the index would benefit from CompactView.
+// See http://docs.couchdb.org/en/2.1.1/api/ddoc/common.html#get--db-_design-ddoc-_info
+func (db *DB) DesignInfo(ctx context.Context, ddoc string) (*DesignInfo, error) {
+	if ddoc == "" {
+		return nil, missingArg("ddoc")
+	}
+	infoer, ok := db.driverDB.(driver.DesignInfoer)
+	if !ok {
+		return nil, errors.Status(StatusNotImplemented, "kivik: design doc info not supported by driver")
+	}
+	info, err := infoer.DesignInfo(ctx, strings.TrimPrefix(ddoc, "_design/"))
+	if err != nil {
+		return nil, err
+	}
+	i := DesignInfo(*info)
+	return &i, nil
+}
+
+// ViewUpdateSeq returns the update sequence up to which the view index of
+// ddoc is built, as reported by DesignInfo, and the current update sequence of
+// the database. All views in a design document share one index, so view is
+// only checked for presence. Neither request triggers an index update.
+//
+// On CouchDB 1.x, both sequences are integers, and the index is caught up when
+// they are equal. On clustered servers, sequences are opaque strings which may
+// be produced by different shard copies, so they should not be compared
+// directly; to wait for an index to be built, query the view with the update
+// option instead. Drivers must implement driver.DesignInfoer.
+func (db *DB) ViewUpdateSeq(ctx context.Context, ddoc, view string) (indexed, current string, err error) {
+	if ddoc == "" {
+		return "", "", missingArg("ddoc")
+	}
+	if view == "" {
+		return "", "", missingArg("view")
+	}
+	info, err := db.DesignInfo(ctx, ddoc)
+	if err != nil {
+		return "", "", err
+	}
+	stats, err := db.Stats(ctx)
+	if err != nil {
+		return "", "", err
+	}
+	return info.UpdateSeq, stats.UpdateSeq, nil
+}
+
+// Search queries the full-text search index named index, in the design
+// document ddoc, as supported by Cloudant, and by CouchDB with Clouseau. ddoc
+// and index may or may not be prefixed with '_design/' and '_search/'
+// respectively. Search options include query, bookmark, limit, sort,
+// include_docs, counts and ranges.
+//
+// For each result row, ScanKey decodes the sort order, and ScanValue the
+// stored fields. Bookmark and TotalRows of the returned Rows support
+// pagination, and Counts and Ranges return the results of faceted queries.
+// See https://console.bluemix.net/docs/services/Cloudant/api/search.html#queries
+func (db *DB) Search(ctx context.Context, ddoc, index string, options ...Options) (*Rows, error) {
+	if ddoc == "" {
+		return nil, missingArg("ddoc")
+	}
+	if index == "" {
+		return nil, missingArg("index")
+	}
+	searcher, ok := db.driverDB.(driver.Searcher)
+	if !ok {
+		return nil, errors.Status(StatusNotImplemented, "kivik: search not supported by driver")
+	}
+	opts, err := mergeOptions(options...)
+	if err != nil {
+		return nil, err
+	}
+	ddoc = strings.TrimPrefix(ddoc, "_design/")
+	index = strings.TrimPrefix(index, "_search/")
+	rowsi, err := searcher.Search(ctx, ddoc, index, opts)
+	if err != nil {
+		return nil, err
+	}
+	return newRows(ctx, rowsi), nil
+}
+
+// Geo queries the Cloudant geospatial index named index, in the design
+// document ddoc. ddoc and index may or may not be prefixed with '_design/' and
+// '_geo/' respectively. Options include bbox, lat, lon, radius, relation and
+// format. On servers without geospatial indexes, such as CouchDB, or if the
+// driver does not implement driver.GeoQuerier, an error with status
+// StatusNotImplemented is returned. StatusNotFound indicates a missing design
+// document or index.
+//
+// For each result row, ScanValue decodes the geometry of the result, and
+// ScanDoc the document, when include_docs is set. With format=geojson, the
+// server returns a GeoJSON FeatureCollection rather than rows; drivers return
+// each member of its features array as a row, so ScanValue decodes a complete
+// GeoJSON Feature, and Bookmark returns the collection's bookmark.
+// See https://console.bluemix.net/docs/services/Cloudant/api/cloudant-geo.html#querying-a-cloudant-geo-index
+func (db *DB) Geo(ctx context.Context, ddoc, index string, options ...Options) (*Rows, error) {
+	if ddoc == "" {
+		return nil, missingArg("ddoc")
+	}
+	if index == "" {
+		return nil, missingArg("index")
+	}
+	geo, ok := db.driverDB.(driver.GeoQuerier)
+	if !ok {
+		return nil, errors.Status(StatusNotImplemented, "kivik: geospatial queries not supported by driver")
+	}
+	opts, err := mergeOptions(options...)
+	if err != nil {
+		return nil, err
+	}
+	ddoc = strings.TrimPrefix(ddoc, "_design/")
+	index = strings.TrimPrefix(index, "_geo/")
+	rowsi, err := geo.Geo(ctx, ddoc, index, opts)
+	if err != nil {
+		return nil, err
+	}
+	return newRows(ctx, rowsi), nil
+}
@@ -0,0 +1,634 @@
+package kivik
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/flimzy/diff"
+	"github.com/flimzy/testy"
+	"github.com/go-kivik/kivik/driver"
+	"github.com/go-kivik/kivik/errors"
+	"github.com/go-kivik/kivik/mock"
+)
+
+func TestShow(t *testing.T) {
+	tests := []struct {
+		name        string
+		db          *DB
+		ddoc, show  string
+		docID       string
+		options     Options
+		contentType string
+		body        string
+		status      int
+		err         string
+	}{
+		{
+			name:   "no ddoc",
+			status: StatusBadRequest,
+			err:    "kivik: ddoc required",
+		},
+		{
+			name:   "no show",
+			ddoc:   "foo",
+			status: StatusBadRequest,
+			err:    "kivik: show required",
+		},
+		{
+			name:   "non-Shower",
+			db:     &DB{driverDB: &mock.DB{}},
+			ddoc:   "foo",
+			show:   "bar",
+			status: StatusNotImplemented,
+			err:    "kivik: show functions not supported by driver",
+		},
+		{
+			name: "db error",
+			db: &DB{
+				driverDB: &mock.Shower{
+					ShowFunc: func(_ context.Context, _, _, _ string, _ map[string]interface{}) (string, io.ReadCloser, error) {
+						return "", nil, errors.Status(StatusNotFound, "missing")
+					},
+				},
+			},
+			ddoc:   "foo",
+			show:   "bar",
+			status: StatusNotFound,
+			err:    "missing",
+		},
+		{
+			name: "success",
+			db: &DB{
+				driverDB: &mock.Shower{
+					ShowFunc: func(_ context.Context, ddoc, show, docID string, opts map[string]interface{}) (string, io.ReadCloser, error) {
+						if ddoc != "foo" || show != "bar" || docID != "baz" {
+							return "", nil, errors.Errorf("Unexpected arguments: %s, %s, %s", ddoc, show, docID)
+						}
+						if d := diff.Interface(testOptions, opts); d != nil {
+							return "", nil, errors.Errorf("Unexpected options:\n%s", d)
+						}
+						return "text/html", body("<h1>baz</h1>"), nil
+					},
+				},
+			},
+			ddoc:        "_design/foo",
+			show:        "_show/bar",
+			docID:       "baz",
+			options:     testOptions,
+			contentType: "text/html",
+			body:        "<h1>baz</h1>",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			contentType, content, err := test.db.Show(context.Background(), test.ddoc, test.show, test.docID, test.options)
+			testy.StatusError(t, test.err, test.status, err)
+			defer content.Close() // nolint: errcheck
+			if contentType != test.contentType {
+				t.Errorf("Unexpected content type: %s", contentType)
+			}
+			result, err := ioutil.ReadAll(content)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(result) != test.body {
+				t.Errorf("Unexpected body: %s", result)
+			}
+		})
+	}
+}
+
+func TestList(t *testing.T) {
+	tests := []struct {
+		name        string
+		db          *DB
+		ddoc, list  string
+		view        string
+		options     Options
+		contentType string
+		body        string
+		status      int
+		err         string
+	}{
+		{
+			name:   "no ddoc",
+			status: StatusBadRequest,
+			err:    "kivik: ddoc required",
+		},
+		{
+			name:   "no list",
+			ddoc:   "foo",
+			status: StatusBadRequest,
+			err:    "kivik: list required",
+		},
+		{
+			name:   "no view",
+			ddoc:   "foo",
+			list:   "bar",
+			status: StatusBadRequest,
+			err:    "kivik: view required",
+		},
+		{
+			name:   "non-Lister",
+			db:     &DB{driverDB: &mock.DB{}},
+			ddoc:   "foo",
+			list:   "bar",
+			view:   "baz",
+			status: StatusNotImplemented,
+			err:    "kivik: list functions not supported by driver",
+		},
+		{
+			name: "db error",
+			db: &DB{
+				driverDB: &mock.Lister{
+					ListFunc: func(_ context.Context, _, _, _ string, _ map[string]interface{}) (string, io.ReadCloser, error) {
+						return "", nil, errors.Status(StatusNotFound, "missing")
+					},
+				},
+			},
+			ddoc:   "foo",
+			list:   "bar",
+			view:   "baz",
+			status: StatusNotFound,
+			err:    "missing",
+		},
+		{
+			name: "success",
+			db: &DB{
+				driverDB: &mock.Lister{
+					ListFunc: func(_ context.Context, ddoc, list, view string, opts map[string]interface{}) (string, io.ReadCloser, error) {
+						if ddoc != "foo" || list != "bar" || view != "other/baz" {
+							return "", nil, errors.Errorf("Unexpected arguments: %s, %s, %s", ddoc, list, view)
+						}
+						if d := diff.Interface(testOptions, opts); d != nil {
+							return "", nil, errors.Errorf("Unexpected options:\n%s", d)
+						}
+						return "text/csv", body("a,b\n"), nil
+					},
+				},
+			},
+			ddoc:        "_design/foo",
+			list:        "_list/bar",
+			view:        "other/baz",
+			options:     testOptions,
+			contentType: "text/csv",
+			body:        "a,b\n",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			contentType, content, err := test.db.List(context.Background(), test.ddoc, test.list, test.view, test.options)
+			testy.StatusError(t, test.err, test.status, err)
+			defer content.Close() // nolint: errcheck
+			if contentType != test.contentType {
+				t.Errorf("Unexpected content type: %s", contentType)
+			}
+			result, err := ioutil.ReadAll(content)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(result) != test.body {
+				t.Errorf("Unexpected body: %s", result)
+			}
+		})
+	}
+}
+
+func TestUpdateFunc(t *testing.T) {
+	tests := []struct {
+		name         string
+		db           *DB
+		ddoc, update string
+		docID        string
+		body         io.Reader
+		contentType  string
+		options      Options
+		newRev       string
+		respBody     string
+		status       int
+		err          string
+	}{
+		{
+			name:   "no ddoc",
+			status: StatusBadRequest,
+			err:    "kivik: ddoc required",
+		},
+		{
+			name:   "no update",
+			ddoc:   "foo",
+			status: StatusBadRequest,
+			err:    "kivik: update required",
+		},
+		{
+			name:   "non-UpdateFuncer",
+			db:     &DB{driverDB: &mock.DB{}},
+			ddoc:   "foo",
+			update: "bar",
+			status: StatusNotImplemented,
+			err:    "kivik: update functions not supported by driver",
+		},
+		{
+			name: "db error",
+			db: &DB{
+				driverDB: &mock.UpdateFuncer{
+					UpdateFuncFunc: func(_ context.Context, _, _, _ string, _ io.Reader, _ string, _ map[string]interface{}) (string, []byte, error) {
+						return "", nil, errors.Status(StatusForbidden, "forbidden")
+					},
+				},
+			},
+			ddoc:   "foo",
+			update: "bar",
+			status: StatusForbidden,
+			err:    "forbidden",
+		},
+		{
+			name: "success",
+			db: &DB{
+				driverDB: &mock.UpdateFuncer{
+					UpdateFuncFunc: func(_ context.Context, ddoc, update, docID string, body io.Reader, contentType string, opts map[string]interface{}) (string, []byte, error) {
+						if ddoc != "foo" || update != "bar" || docID != "baz" {
+							return "", nil, errors.Errorf("Unexpected arguments: %s, %s, %s", ddoc, update, docID)
+						}
+						if contentType != "application/json" {
+							return "", nil, errors.Errorf("Unexpected content type: %s", contentType)
+						}
+						content, err := ioutil.ReadAll(body)
+						if err != nil {
+							return "", nil, err
+						}
+						if string(content) != `{"foo":"bar"}` {
+							return "", nil, errors.Errorf("Unexpected body: %s", content)
+						}
+						if d := diff.Interface(testOptions, opts); d != nil {
+							return "", nil, errors.Errorf("Unexpected options:\n%s", d)
+						}
+						return "2-xxx", []byte("updated"), nil
+					},
+				},
+			},
+			ddoc:        "_design/foo",
+			update:      "_update/bar",
+			docID:       "baz",
+			body:        body(`{"foo":"bar"}`),
+			contentType: "application/json",
+			options:     testOptions,
+			newRev:      "2-xxx",
+			respBody:    "updated",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			newRev, respBody, err := test.db.UpdateFunc(context.Background(), test.ddoc, test.update, test.docID, test.body, test.contentType, test.options)
+			testy.StatusError(t, test.err, test.status, err)
+			if newRev != test.newRev {
+				t.Errorf("Unexpected rev: %s", newRev)
+			}
+			if string(respBody) != test.respBody {
+				t.Errorf("Unexpected response body: %s", respBody)
+			}
+		})
+	}
+}
+
+func TestDesignInfo(t *testing.T) {
+	tests := []struct {
+		name     string
+		db       *DB
+		ddoc     string
+		expected *DesignInfo
+		status   int
+		err      string
+	}{
+		{
+			name:   "no ddoc",
+			status: StatusBadRequest,
+			err:    "kivik: ddoc required",
+		},
+		{
+			name:   "non-DesignInfoer",
+			db:     &DB{driverDB: &mock.DB{}},
+			ddoc:   "foo",
+			status: StatusNotImplemented,
+			err:    "kivik: design doc info not supported by driver",
+		},
+		{
+			name: "db error",
+			db: &DB{
+				driverDB: &mock.DesignInfoer{
+					DesignInfoFunc: func(_ context.Context, _ string) (*driver.DesignInfo, error) {
+						return nil, errors.Status(StatusNotFound, "missing")
+					},
+				},
+			},
+			ddoc:   "foo",
+			status: StatusNotFound,
+			err:    "missing",
+		},
+		{
+			name: "success",
+			db: &DB{
+				driverDB: &mock.DesignInfoer{
+					DesignInfoFunc: func(_ context.Context, ddoc string) (*driver.DesignInfo, error) {
+						if ddoc != "foo" {
+							return nil, errors.Errorf("Unexpected ddoc: %s", ddoc)
+						}
+						return &driver.DesignInfo{
+							Name:           "foo",
+							Signature:      "abc",
+							UpdateSeq:      "5-abc",
+							DataSize:       100,
+							DiskSize:       300,
+							UpdaterRunning: true,
+							WaitingClients: 2,
+						}, nil
+					},
+				},
+			},
+			ddoc: "_design/foo",
+			expected: &DesignInfo{
+				Name:           "foo",
+				Signature:      "abc",
+				UpdateSeq:      "5-abc",
+				DataSize:       100,
+				DiskSize:       300,
+				UpdaterRunning: true,
+				WaitingClients: 2,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := test.db.DesignInfo(context.Background(), test.ddoc)
+			testy.StatusError(t, test.err, test.status, err)
+			if d := diff.Interface(test.expected, result); d != nil {
+				t.Error(d)
+			}
+		})
+	}
+}
+
+func TestSearch(t *testing.T) {
+	tests := []struct {
+		name        string
+		db          *DB
+		ddoc, index string
+		options     Options
+		expected    *Rows
+		status      int
+		err         string
+	}{
+		{
+			name:   "no ddoc",
+			status: StatusBadRequest,
+			err:    "kivik: ddoc required",
+		},
+		{
+			name:   "no index",
+			ddoc:   "foo",
+			status: StatusBadRequest,
+			err:    "kivik: index required",
+		},
+		{
+			name:   "non-Searcher",
+			db:     &DB{driverDB: &mock.DB{}},
+			ddoc:   "foo",
+			index:  "bar",
+			status: StatusNotImplemented,
+			err:    "kivik: search not supported by driver",
+		},
+		{
+			name: "db error",
+			db: &DB{
+				driverDB: &mock.Searcher{
+					SearchFunc: func(_ context.Context, _, _ string, _ map[string]interface{}) (driver.Rows, error) {
+						return nil, errors.Status(StatusBadRequest, "invalid query")
+					},
+				},
+			},
+			ddoc:   "foo",
+			index:  "bar",
+			status: StatusBadRequest,
+			err:    "invalid query",
+		},
+		{
+			name: "success",
+			db: &DB{
+				driverDB: &mock.Searcher{
+					SearchFunc: func(_ context.Context, ddoc, index string, opts map[string]interface{}) (driver.Rows, error) {
+						if ddoc != "foo" || index != "bar" {
+							return nil, errors.Errorf("Unexpected index: %s/%s", ddoc, index)
+						}
+						if d := diff.Interface(testOptions, opts); d != nil {
+							return nil, errors.Errorf("Unexpected options:\n%s", d)
+						}
+						return &mock.Rows{ID: "a"}, nil
+					},
+				},
+			},
+			ddoc:    "_design/foo",
+			index:   "_search/bar",
+			options: testOptions,
+			expected: &Rows{
+				iter: &iter{
+					feed: &rowsIterator{
+						Rows: &mock.Rows{ID: "a"},
+					},
+					curVal: &driver.Row{},
+				},
+				rowsi: &mock.Rows{ID: "a"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := test.db.Search(context.Background(), test.ddoc, test.index, test.options)
+			testy.StatusError(t, test.err, test.status, err)
+			result.cancel = nil // Determinism
+			if d := diff.Interface(test.expected, result); d != nil {
+				t.Error(d)
+			}
+		})
+	}
+}
+
+func TestGeo(t *testing.T) {
+	tests := []struct {
+		name        string
+		db          *DB
+		ddoc, index string
+		options     Options
+		expected    *Rows
+		status      int
+		err         string
+	}{
+		{
+			name:   "no ddoc",
+			status: StatusBadRequest,
+			err:    "kivik: ddoc required",
+		},
+		{
+			name:   "no index",
+			ddoc:   "foo",
+			status: StatusBadRequest,
+			err:    "kivik: index required",
+		},
+		{
+			name:   "non-GeoQuerier",
+			db:     &DB{driverDB: &mock.DB{}},
+			ddoc:   "foo",
+			index:  "bar",
+			status: StatusNotImplemented,
+			err:    "kivik: geospatial queries not supported by driver",
+		},
+		{
+			name: "db error",
+			db: &DB{
+				driverDB: &mock.GeoQuerier{
+					GeoFunc: func(_ context.Context, _, _ string, _ map[string]interface{}) (driver.Rows, error) {
+						return nil, errors.Status(StatusBadRequest, "invalid query")
+					},
+				},
+			},
+			ddoc:   "foo",
+			index:  "bar",
+			status: StatusBadRequest,
+			err:    "invalid query",
+		},
+		{
+			name: "success",
+			db: &DB{
+				driverDB: &mock.GeoQuerier{
+					GeoFunc: func(_ context.Context, ddoc, index string, opts map[string]interface{}) (driver.Rows, error) {
+						if ddoc != "foo" || index != "bar" {
+							return nil, errors.Errorf("Unexpected index: %s/%s", ddoc, index)
+						}
+						if d := diff.Interface(testOptions, opts); d != nil {
+							return nil, errors.Errorf("Unexpected options:\n%s", d)
+						}
+						return &mock.Rows{ID: "a"}, nil
+					},
+				},
+			},
+			ddoc:    "_design/foo",
+			index:   "_geo/bar",
+			options: testOptions,
+			expected: &Rows{
+				iter: &iter{
+					feed: &rowsIterator{
+						Rows: &mock.Rows{ID: "a"},
+					},
+					curVal: &driver.Row{},
+				},
+				rowsi: &mock.Rows{ID: "a"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := test.db.Geo(context.Background(), test.ddoc, test.index, test.options)
+			testy.StatusError(t, test.err, test.status, err)
+			result.cancel = nil // Determinism
+			if d := diff.Interface(test.expected, result); d != nil {
+				t.Error(d)
+			}
+		})
+	}
+}
+
+func TestViewUpdateSeq(t *testing.T) {
+	tests := []struct {
+		name       string
+		db         *DB
+		ddoc, view string
+		indexed    string
+		current    string
+		status     int
+		err        string
+	}{
+		{
+			name:   "no ddoc",
+			status: StatusBadRequest,
+			err:    "kivik: ddoc required",
+		},
+		{
+			name:   "no view",
+			ddoc:   "foo",
+			status: StatusBadRequest,
+			err:    "kivik: view required",
+		},
+		{
+			name:   "non-DesignInfoer",
+			db:     &DB{driverDB: &mock.DB{}},
+			ddoc:   "foo",
+			view:   "bar",
+			status: StatusNotImplemented,
+			err:    "kivik: design doc info not supported by driver",
+		},
+		{
+			name: "info error",
+			db: &DB{
+				driverDB: &mock.DesignInfoer{
+					DesignInfoFunc: func(_ context.Context, _ string) (*driver.DesignInfo, error) {
+						return nil, errors.Status(StatusNotFound, "missing")
+					},
+				},
+			},
+			ddoc:   "foo",
+			view:   "bar",
+			status: StatusNotFound,
+			err:    "missing",
+		},
+		{
+			name: "stats error",
+			db: &DB{
+				driverDB: &mock.DesignInfoer{
+					DB: &mock.DB{
+						StatsFunc: func(_ context.Context) (*driver.DBStats, error) {
+							return nil, errors.Status(StatusUnauthorized, "unauthorized")
+						},
+					},
+					DesignInfoFunc: func(_ context.Context, _ string) (*driver.DesignInfo, error) {
+						return &driver.DesignInfo{UpdateSeq: "5-abc"}, nil
+					},
+				},
+			},
+			ddoc:   "foo",
+			view:   "bar",
+			status: StatusUnauthorized,
+			err:    "unauthorized",
+		},
+		{
+			name: "success",
+			db: &DB{
+				driverDB: &mock.DesignInfoer{
+					DB: &mock.DB{
+						StatsFunc: func(_ context.Context) (*driver.DBStats, error) {
+							return &driver.DBStats{UpdateSeq: "7-abc"}, nil
+						},
+					},
+					DesignInfoFunc: func(_ context.Context, ddoc string) (*driver.DesignInfo, error) {
+						if ddoc != "foo" {
+							return nil, errors.Errorf("Unexpected ddoc: %s", ddoc)
+						}
+						return &driver.DesignInfo{UpdateSeq: "5-abc"}, nil
+					},
+				},
+			},
+			ddoc:    "_design/foo",
+			view:    "bar",
+			indexed: "5-abc",
+			current: "7-abc",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			indexed, current, err := test.db.ViewUpdateSeq(context.Background(), test.ddoc, test.view)
+			testy.StatusError(t, test.err, test.status, err)
+			if indexed != test.indexed || current != test.current {
+				t.Errorf("Unexpected result: %s / %s", indexed, current)
+			}
+		})
+	}
+}
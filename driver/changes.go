@@ -7,12 +7,28 @@ type Changes interface {
 	// Next is called to populate *Change with the next value in the changes
 	// feed.
 	//
+	// Heartbeats sent by the server (empty lines in continuous mode) must be
+	// consumed silently by the driver, and never returned as a Change.
+	//
 	// Next should return io.EOF when the changes feed is closed by request.
+	// If the feed times out, because neither data nor a heartbeat was
+	// received in time, Next should return an error with status 408
+	// (Request Timeout), so that callers may distinguish this from a normal
+	// close and reconnect.
 	Next(*Change) error
 	// Close closes the rows iterator.
 	Close() error
 }
 
+// LastSeqer is an optional interface that may be implemented by a Changes
+// iterator, to report the last_seq value sent by the server at the end of the
+// changes feed.
+type LastSeqer interface {
+	// LastSeq returns the last update sequence reported by the feed. It is
+	// only expected to be set once the feed has been exhausted.
+	LastSeq() string
+}
+
 // Change represents the changes to a single document.
 type Change struct {
 	// ID is the document ID to which the change relates.
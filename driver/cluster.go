@@ -0,0 +1,19 @@
+package driver
+
+import "context"
+
+// ClusterMembership contains the list of known nodes, and cluster nodes, as
+// returned by the /_membership endpoint.
+type ClusterMembership struct {
+	AllNodes     []string `json:"all_nodes"`
+	ClusterNodes []string `json:"cluster_nodes"`
+}
+
+// Cluster is an optional interface that may be implemented by a Client for
+// servers that support clustering.
+type Cluster interface {
+	// Membership returns the cluster membership of the server. Drivers for
+	// servers which are not clustered, such as CouchDB 1.x, should return an
+	// error with status 501 (Not Implemented).
+	Membership(ctx context.Context) (*ClusterMembership, error)
+}
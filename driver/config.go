@@ -0,0 +1,26 @@
+package driver
+
+import "context"
+
+// Config represents all the config sections.
+type Config map[string]ConfigSection
+
+// ConfigSection represents all key/value pairs for a section of configuration.
+type ConfigSection map[string]string
+
+// Configer is an optional interface that may be implemented by a Client to
+// allow access to reading and setting server configuration. node is the name
+// of the node to configure; drivers for servers without node-level
+// configuration (such as CouchDB 1.x) may ignore it.
+type Configer interface {
+	// Config returns the entire server config.
+	Config(ctx context.Context, node string) (Config, error)
+	// ConfigSection returns a single section of the server config.
+	ConfigSection(ctx context.Context, node, section string) (ConfigSection, error)
+	// ConfigValue returns a single config value.
+	ConfigValue(ctx context.Context, node, section, key string) (string, error)
+	// SetConfigValue sets a config value, and returns the old value.
+	SetConfigValue(ctx context.Context, node, section, key, value string) (string, error)
+	// DeleteConfigValue deletes a config value, and returns the old value.
+	DeleteConfigValue(ctx context.Context, node, section, key string) (string, error)
+}
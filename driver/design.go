@@ -0,0 +1,80 @@
+package driver
+
+import (
+	"context"
+	"io"
+)
+
+// Shower is an optional interface that may be implemented by a DB to support
+// design document show functions.
+type Shower interface {
+	// Show renders docID through the named show function, and returns the
+	// content type and body of the response. docID may be empty. The caller
+	// is responsible for closing body.
+	Show(ctx context.Context, ddoc, show, docID string, options map[string]interface{}) (contentType string, body io.ReadCloser, err error)
+}
+
+// Lister is an optional interface that may be implemented by a DB to support
+// design document list functions.
+type Lister interface {
+	// List renders the named view through the named list function, and
+	// returns the content type and body of the response. view may be of the
+	// form "other-ddoc/view" to use a view from another design document. The
+	// caller is responsible for closing body.
+	List(ctx context.Context, ddoc, list, view string, options map[string]interface{}) (contentType string, body io.ReadCloser, err error)
+}
+
+// UpdateFuncer is an optional interface that may be implemented by a DB to
+// support design document update functions.
+type UpdateFuncer interface {
+	// UpdateFunc sends body, with the given content type, to the named update
+	// function for docID, using PUT, or to create a new document using POST
+	// if docID is empty. It returns the new revision, as reported in the
+	// X-Couch-Update-NewRev header, if any, and the response body.
+	UpdateFunc(ctx context.Context, ddoc, update, docID string, body io.Reader, contentType string, options map[string]interface{}) (newRev string, respBody []byte, err error)
+}
+
+// DesignInfo represents the view index information of a design document.
+type DesignInfo struct {
+	Name           string
+	Signature      string
+	UpdateSeq      string
+	DataSize       int64
+	DiskSize       int64
+	CompactRunning bool
+	UpdaterRunning bool
+	WaitingClients int64
+}
+
+// DesignInfoer is an optional interface that may be implemented by a DB to
+// report view index information of design documents.
+type DesignInfoer interface {
+	// DesignInfo returns the view index information for ddoc.
+	DesignInfo(ctx context.Context, ddoc string) (*DesignInfo, error)
+}
+
+// Searcher is an optional interface that may be implemented by a DB to support
+// full-text search indexes, as provided by Cloudant, or CouchDB with
+// Clouseau.
+type Searcher interface {
+	// Search queries the named search index. Each returned row should have
+	// the sort order of the result as its Key, and the stored fields as its
+	// Value. Drivers should implement Bookmarker to support pagination, and
+	// Faceter to support the counts and ranges options.
+	Search(ctx context.Context, ddoc, index string, options map[string]interface{}) (Rows, error)
+}
+
+// GeoQuerier is an optional interface that may be implemented by a DB to
+// support Cloudant geospatial indexes.
+type GeoQuerier interface {
+	// Geo queries the named geospatial index. Each returned row should have
+	// the geometry of the result as its Value. For format=geojson, each
+	// Feature of the returned FeatureCollection should be returned as a row,
+	// with the Feature as its Value, and the collection's bookmark returned
+	// by Bookmarker.
+	//
+	// If the server does not support geospatial indexes, Geo should return an
+	// error with status 501 (Not Implemented), rather than the server's 404,
+	// which is reserved for a missing design document or index.
+	Geo(ctx context.Context, ddoc, index string, options map[string]interface{}) (Rows, error)
+}
@@ -23,6 +23,10 @@ type Version struct {
 	// Features is a list of enabled, optional features.  This was added in
 	// CouchDB 2.1.0, and can be expected to be empty for older versions.
 	Features []string
+	// UUID is the unique identifier of the server instance.
+	UUID string
+	// GitSHA is the git commit from which the server was built.
+	GitSHA string
 	// RawResponse is the raw response body as returned by the server.
 	RawResponse json.RawMessage
 }
@@ -96,6 +100,24 @@ type ClientReplicator interface {
 	GetReplications(ctx context.Context, options map[string]interface{}) ([]Replication, error)
 }
 
+// ClientCloser is an optional interface that may be implemented by a Client
+// to clean up resources when a Client is no longer needed.
+type ClientCloser interface {
+	// Close releases any resources held by the client, such as idle
+	// connections owned by the driver. Resources owned by the caller, such as
+	// a user-supplied HTTP client, should be left intact.
+	Close(ctx context.Context) error
+}
+
+// Pinger is an optional interface that may be implemented by a Client. When
+// not implemented, Kivik will call Version instead.
+type Pinger interface {
+	// Ping returns true if the database server is reachable and responding.
+	// This should be as lightweight a request as the backend allows, such as
+	// a HEAD request.
+	Ping(ctx context.Context) (bool, error)
+}
+
 // Authenticator is an optional interface that may be implemented by a Client
 // that supports authenitcated connections.
 type Authenticator interface {
@@ -137,6 +159,53 @@ type Members struct {
 type Security struct {
 	Admins  Members `json:"admins"`
 	Members Members `json:"members"`
+	// Extra holds any other top-level fields of the security document, so
+	// they are preserved on round-trip.
+	Extra map[string]interface{} `json:"-"`
+}
+
+// MarshalJSON satisfies the json.Marshaler interface, including any Extra
+// fields in the output.
+func (s Security) MarshalJSON() ([]byte, error) {
+	doc := make(map[string]interface{}, len(s.Extra)+2)
+	for k, v := range s.Extra {
+		doc[k] = v
+	}
+	doc["admins"] = s.Admins
+	doc["members"] = s.Members
+	return json.Marshal(doc)
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface, storing any fields
+// other than admins and members in Extra.
+func (s *Security) UnmarshalJSON(data []byte) error {
+	var doc map[string]json.RawMessage
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+	sec := Security{}
+	for k, v := range doc {
+		var err error
+		switch k {
+		case "admins":
+			err = json.Unmarshal(v, &sec.Admins)
+		case "members":
+			err = json.Unmarshal(v, &sec.Members)
+		default:
+			var value interface{}
+			if err = json.Unmarshal(v, &value); err == nil {
+				if sec.Extra == nil {
+					sec.Extra = make(map[string]interface{})
+				}
+				sec.Extra[k] = value
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	*s = sec
+	return nil
 }
 
 // DB is a database handle.
@@ -196,12 +265,13 @@ type Document struct {
 	// format.
 	Body io.ReadCloser
 
-	// Attachments will be nil except when include_docs=true.
+	// Attachments will be nil except when attachments=true, and the
+	// attachments are returned in a multipart/related response.
 	Attachments Attachments
 }
 
 // Attachments is an iterator over the attachments included in a document when
-// Get is called with `include_docs=true`.
+// Get is called with `attachments=true`.
 type Attachments interface {
 	// Next is called to pupulate att with the next attachment in the result
 	// set.
@@ -228,6 +298,30 @@ type PurgeResult struct {
 	Purged map[string][]string `json:"purged"`
 }
 
+// RevsDiffer is an optional interface which may be implemented by a DB to
+// support the revisions diff operation used by replication.
+type RevsDiffer interface {
+	// RevsDiff returns, for each document in revMap, the revisions not stored
+	// in the database.
+	RevsDiff(ctx context.Context, revMap map[string][]string) (map[string]RevDiff, error)
+}
+
+// RevsLimiter is an optional interface which may be implemented by a DB to
+// support reading and setting the revision history limit.
+type RevsLimiter interface {
+	// RevsLimit returns the maximum number of revisions tracked per document.
+	RevsLimit(ctx context.Context) (int, error)
+	// SetRevsLimit sets the maximum number of revisions tracked per document.
+	SetRevsLimit(ctx context.Context, limit int) error
+}
+
+// RevDiff is the missing revisions for a single document, as returned by a
+// RevsDiff request.
+type RevDiff struct {
+	Missing           []string `json:"missing,omitempty"`
+	PossibleAncestors []string `json:"possible_ancestors,omitempty"`
+}
+
 // BulkDocer is an optional interface which may be implemented by a DB to
 // support bulk insert/update operations. For any driver that does not support
 // the BulkDocer interface, the Put or CreateDoc methods will be called for each
@@ -239,6 +333,22 @@ type BulkDocer interface {
 	BulkDocs(ctx context.Context, docs []interface{}, options map[string]interface{}) (BulkResults, error)
 }
 
+// BulkGetReference is a reference to a document given in a BulkGet query.
+type BulkGetReference struct {
+	ID        string `json:"id"`
+	Rev       string `json:"rev,omitempty"`
+	AttsSince string `json:"atts_since,omitempty"`
+}
+
+// BulkGetter is an optional interface which may be implemented by a DB to
+// support bulk get operations.
+type BulkGetter interface {
+	// BulkGet uses the _bulk_get interface to fetch multiple documents in a
+	// single request. Documents which cannot be fetched should be returned as
+	// rows with a non-nil Error, rather than failing the entire request.
+	BulkGet(ctx context.Context, docs []BulkGetReference, options map[string]interface{}) (Rows, error)
+}
+
 // Finder is an optional interface which may be implemented by a DB. The Finder
 // interface provides access to the new (in CouchDB 2.0) MongoDB-style query
 // interface.
@@ -306,6 +416,16 @@ type AttachmentMetaGetter interface {
 	GetAttachmentMeta(ctx context.Context, docID, rev, filename string, options map[string]interface{}) (*Attachment, error)
 }
 
+// AttachmentRangeGetter is an optional interface which may be satisfied by a
+// DB. If satisfied, it may be used to fetch a byte range of an attachment, such
+// as with an HTTP Range request.
+type AttachmentRangeGetter interface {
+	// GetAttachmentRange fetches bytes start through end, inclusive, of the
+	// attachment. If the backend ignores the range and returns the full
+	// attachment instead, an error should be returned.
+	GetAttachmentRange(ctx context.Context, docID, rev, filename string, start, end int64, options map[string]interface{}) (*Attachment, error)
+}
+
 // BulkResult is the result of a single doc update in a BulkDocs request.
 type BulkResult struct {
 	ID    string `json:"id"`
@@ -69,3 +69,19 @@ type Bookmarker interface {
 	// usage: http://docs.couchdb.org/en/2.1.1/api/database/find.html#pagination
 	Bookmark() string
 }
+
+// Facets is the faceted search results for a single field, mapping each
+// value or range name to the number of matching documents.
+type Facets map[string]int64
+
+// Faceter is an optional interface that may be implemented by a Rows returned
+// by Searcher, to return the facet counts and ranges requested with the
+// counts and ranges options.
+type Faceter interface {
+	// Counts returns the per-value counts for each field named in the counts
+	// option.
+	Counts() map[string]Facets
+	// Ranges returns the per-range counts for each field named in the ranges
+	// option.
+	Ranges() map[string]Facets
+}
@@ -0,0 +1,54 @@
+package driver
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// SchedulerEvent is a single event in the history of a replication job.
+type SchedulerEvent struct {
+	Timestamp time.Time
+	Type      string
+	Reason    string
+}
+
+// SchedulerJob represents a replication job, as reported by
+// /_scheduler/jobs.
+type SchedulerJob struct {
+	Database  string
+	DocID     string
+	ID        string
+	Node      string
+	PID       string
+	Source    string
+	Target    string
+	StartTime time.Time
+	History   []SchedulerEvent
+	Info      json.RawMessage
+}
+
+// SchedulerDoc represents the state of a replication document, as reported by
+// /_scheduler/docs.
+type SchedulerDoc struct {
+	Database    string
+	DocID       string
+	ID          string
+	Node        string
+	Source      string
+	Target      string
+	State       string
+	ErrorCount  int
+	StartTime   time.Time
+	LastUpdated time.Time
+	Info        json.RawMessage
+}
+
+// Scheduler is an optional interface that may be implemented by a Client to
+// report the state of the replication scheduler, added in CouchDB 2.1.0.
+type Scheduler interface {
+	// SchedulerJobs returns the list of replication jobs.
+	SchedulerJobs(ctx context.Context, options map[string]interface{}) ([]*SchedulerJob, error)
+	// SchedulerDocs returns the state of replication documents.
+	SchedulerDocs(ctx context.Context, options map[string]interface{}) ([]*SchedulerDoc, error)
+}
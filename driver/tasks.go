@@ -0,0 +1,25 @@
+package driver
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// Task represents a single running task, as reported by /_active_tasks.
+type Task struct {
+	Type        string
+	Database    string
+	PID         string
+	Progress    float64
+	StartedOn   time.Time
+	UpdatedOn   time.Time
+	RawResponse json.RawMessage
+}
+
+// ActiveTasker is an optional interface that may be implemented by a Client to
+// report tasks running on the server.
+type ActiveTasker interface {
+	// ActiveTasks returns the list of running tasks.
+	ActiveTasks(ctx context.Context) ([]*Task, error)
+}
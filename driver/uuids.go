@@ -0,0 +1,11 @@
+package driver
+
+import "context"
+
+// UUIDer is an optional interface that may be implemented by a Client to
+// support server-generated UUIDs.
+type UUIDer interface {
+	// UUIDs returns count UUIDs generated by the server. count is always
+	// between 1 and the server's max_count, inclusive.
+	UUIDs(ctx context.Context, count int) ([]string, error)
+}
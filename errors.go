@@ -27,16 +27,53 @@ type statusCoder interface {
 //  type statusCoder interface {
 //      StatusCode() int
 //  }
+//
+// If err does not carry a status code, but wraps an error which does (as
+// errors created by github.com/pkg/errors do, via a Cause() method), the
+// status code of the wrapped error is returned.
 func StatusCode(err error) int {
 	if err == nil {
 		return 0
 	}
-	if coder, ok := err.(statusCoder); ok {
-		return coder.StatusCode()
+	for err != nil {
+		if coder, ok := err.(statusCoder); ok {
+			return coder.StatusCode()
+		}
+		c, ok := err.(causer)
+		if !ok {
+			break
+		}
+		err = c.Cause()
 	}
 	return StatusInternalServerError
 }
 
+type causer interface {
+	Cause() error
+}
+
+// IsNotFound returns true if err carries a 404 (not found) status.
+func IsNotFound(err error) bool {
+	return StatusCode(err) == StatusNotFound
+}
+
+// IsConflict returns true if err carries a 409 (conflict) status, as returned
+// when updating a document with a stale revision.
+func IsConflict(err error) bool {
+	return StatusCode(err) == StatusConflict
+}
+
+// IsNotModified returns true if err carries a 304 (not modified) status, as
+// returned by a conditional Get when the document is unchanged.
+func IsNotModified(err error) bool {
+	return StatusCode(err) == StatusNotModified
+}
+
+// IsUnauthorized returns true if err carries a 401 (unauthorized) status.
+func IsUnauthorized(err error) bool {
+	return StatusCode(err) == StatusUnauthorized
+}
+
 type reasoner interface {
 	Reason() string
 }
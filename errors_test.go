@@ -28,6 +28,16 @@ func TestStatusCoder(t *testing.T) {
 			Err:      kerrors.Status(400, "bad request"),
 			Expected: 400,
 		},
+		{
+			Name:     "Wrapped StatusCoder",
+			Err:      kerrors.Wrap(kerrors.Status(404, "not found"), "wrapped"),
+			Expected: 404,
+		},
+		{
+			Name:     "Wrapped standard error",
+			Err:      kerrors.Wrap(errors.New("foo"), "wrapped"),
+			Expected: 500,
+		},
 	}
 	for _, test := range tests {
 		func(test scTest) {
@@ -41,6 +51,66 @@ func TestStatusCoder(t *testing.T) {
 	}
 }
 
+func TestIsStatusHelpers(t *testing.T) {
+	tests := []struct {
+		name         string
+		err          error
+		notFound     bool
+		conflict     bool
+		notModified  bool
+		unauthorized bool
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name: "standard error",
+			err:  errors.New("foo"),
+		},
+		{
+			name:     "not found",
+			err:      kerrors.Status(StatusNotFound, "missing"),
+			notFound: true,
+		},
+		{
+			name:     "conflict",
+			err:      kerrors.Status(StatusConflict, "conflict"),
+			conflict: true,
+		},
+		{
+			name:        "not modified",
+			err:         kerrors.Status(StatusNotModified, "not modified"),
+			notModified: true,
+		},
+		{
+			name:         "unauthorized",
+			err:          kerrors.Status(StatusUnauthorized, "unauthorized"),
+			unauthorized: true,
+		},
+		{
+			name:     "wrapped conflict",
+			err:      kerrors.Wrap(kerrors.Status(StatusConflict, "conflict"), "put failed"),
+			conflict: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if r := IsNotFound(test.err); r != test.notFound {
+				t.Errorf("IsNotFound: expected %t, got %t", test.notFound, r)
+			}
+			if r := IsConflict(test.err); r != test.conflict {
+				t.Errorf("IsConflict: expected %t, got %t", test.conflict, r)
+			}
+			if r := IsNotModified(test.err); r != test.notModified {
+				t.Errorf("IsNotModified: expected %t, got %t", test.notModified, r)
+			}
+			if r := IsUnauthorized(test.err); r != test.unauthorized {
+				t.Errorf("IsUnauthorized: expected %t, got %t", test.unauthorized, r)
+			}
+		})
+	}
+}
+
 type testReasoner int
 
 func (tr testReasoner) Reason() string { return "reason" }
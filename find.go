@@ -10,7 +10,10 @@ import (
 var findNotImplemented = errors.Status(StatusNotImplemented, "kivik: driver does not support Find interface")
 
 // Find executes a query using the new /_find interface. The query must be
-// JSON-marshalable to a valid query.
+// JSON-marshalable to a valid query. A string, []byte, or json.RawMessage
+// value is passed through as a raw JSON query. After iterating the results,
+// the Bookmark and Warning methods of the returned Rows may be consulted for
+// paging, and for any warning generated by the server, respectively.
 // See http://docs.couchdb.org/en/2.0.0/api/database/find.html#db-find
 func (db *DB) Find(ctx context.Context, query interface{}) (*Rows, error) {
 	if finder, ok := db.driverDB.(driver.Finder); ok {
@@ -23,9 +26,10 @@ func (db *DB) Find(ctx context.Context, query interface{}) (*Rows, error) {
 	return nil, findNotImplemented
 }
 
-// CreateIndex creates an index if it doesn't already exist. ddoc and name may
-// be empty, in which case they will be auto-generated.  index must be a valid
-// index object, as described here:
+// CreateIndex creates an index if it doesn't already exist. Creating an index
+// which already exists is not an error. ddoc and name may be empty, in which
+// case they will be auto-generated.  index must be a valid index object, as
+// described here:
 // http://docs.couchdb.org/en/2.0.0/api/database/find.html#find-sort
 func (db *DB) CreateIndex(ctx context.Context, ddoc, name string, index interface{}) error {
 	if finder, ok := db.driverDB.(driver.Finder); ok {
@@ -54,11 +58,14 @@ type Index struct {
 func (db *DB) GetIndexes(ctx context.Context) ([]Index, error) {
 	if finder, ok := db.driverDB.(driver.Finder); ok {
 		dIndexes, err := finder.GetIndexes(ctx)
+		if err != nil {
+			return nil, err
+		}
 		indexes := make([]Index, len(dIndexes))
 		for i, index := range dIndexes {
 			indexes[i] = Index(index)
 		}
-		return indexes, err
+		return indexes, nil
 	}
 	return nil, findNotImplemented
 }
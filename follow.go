@@ -0,0 +1,130 @@
+package kivik
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// Change is a single result from the changes feed, as delivered by Follow.
+type Change struct {
+	// ID is the document ID to which the change relates.
+	ID string
+	// Seq is the update sequence of the change.
+	Seq string
+	// Deleted is true if the document has been deleted.
+	Deleted bool
+	// Changes is the list of document leaf revisions.
+	Changes []string
+	// Doc is the raw JSON document, which is only set when include_docs=true
+	// is passed as an option.
+	Doc json.RawMessage
+}
+
+// followRetryDelay is the time Follow waits before reconnecting to the changes
+// feed after a transient error.
+var followRetryDelay = 5 * time.Second
+
+// followHandlerError wraps an error returned by a Follow handler, to
+// distinguish it from errors reading the feed.
+type followHandlerError struct {
+	error
+}
+
+// Follow consumes the continuous changes feed, calling handler for each
+// change, until ctx is cancelled or handler returns an error, which is then
+// returned by Follow.
+//
+// The sequence of the last handled change is stored in the local document
+// _local/{checkpointID} periodically, and whenever the feed ends, and when
+// Follow is called again with the same checkpointID, the feed resumes from
+// the last stored sequence. If the feed is interrupted by a transient error,
+// such as a network failure, a timeout or a server error, Follow reconnects
+// from the last handled change. As a result, changes are delivered at least
+// once; changes handled since the last stored checkpoint may be redelivered
+// if Follow is interrupted abruptly.
+//
+// Options are passed to Changes, except that feed and since are set by
+// Follow.
+func (db *DB) Follow(ctx context.Context, checkpointID string, handler func(*Change) error, options ...Options) error {
+	if checkpointID == "" {
+		return missingArg("checkpointID")
+	}
+	cp, err := loadCheckpoint(ctx, db, checkpointID)
+	if err != nil {
+		return err
+	}
+	for {
+		err := db.followFeed(ctx, cp, handler, options)
+		flushErr := cp.flush(ctx)
+		if herr, ok := err.(*followHandlerError); ok {
+			return herr.error
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err != nil && !transientError(err) {
+			return err
+		}
+		if flushErr != nil {
+			return flushErr
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(followRetryDelay):
+		}
+	}
+}
+
+// followFeed reads a single connection to the changes feed, until it ends.
+func (db *DB) followFeed(ctx context.Context, cp *checkpoint, handler func(*Change) error, options []Options) error {
+	opts := Options{"feed": "continuous"}
+	if cp.LastSeq != "" {
+		opts["since"] = cp.LastSeq
+	}
+	changes, err := db.Changes(ctx, append(options, opts)...)
+	if err != nil {
+		return err
+	}
+	defer changes.Close() // nolint: errcheck
+	for changes.Next() {
+		change := &Change{
+			ID:      changes.ID(),
+			Seq:     changes.Seq(),
+			Deleted: changes.Deleted(),
+			Changes: changes.Changes(),
+		}
+		var doc []byte
+		if err := changes.ScanDoc(&doc); err != nil {
+			return err
+		}
+		if len(doc) > 0 {
+			change.Doc = doc
+		}
+		if err := handler(change); err != nil {
+			return &followHandlerError{err}
+		}
+		if err := cp.update(ctx, change.Seq); err != nil {
+			return err
+		}
+	}
+	return changes.Err()
+}
+
+// transientError returns true if err may be resolved by retrying the request.
+// A nil error, meaning the feed was closed by the server, is also considered
+// transient, as a continuous feed should not normally end. Status 500 is not
+// considered transient, as it is also the status of unclassified errors.
+func transientError(err error) bool {
+	if err == nil {
+		return true
+	}
+	switch status := StatusCode(err); {
+	case status == StatusNetworkError, status == StatusRequestTimeout:
+		return true
+	case status > StatusInternalServerError && status < StatusUnknownError:
+		return status != StatusNotImplemented
+	}
+	return false
+}
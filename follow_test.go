@@ -0,0 +1,238 @@
+package kivik
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/flimzy/diff"
+	"github.com/flimzy/testy"
+	"github.com/go-kivik/kivik/driver"
+	"github.com/go-kivik/kivik/errors"
+	"github.com/go-kivik/kivik/mock"
+)
+
+// testFeed returns a driver.Changes which delivers changes, followed by err,
+// or io.EOF if err is nil.
+func testFeed(err error, changes ...driver.Change) driver.Changes {
+	return &mock.Changes{
+		NextFunc: func(c *driver.Change) error {
+			if len(changes) == 0 {
+				if err != nil {
+					return err
+				}
+				return io.EOF
+			}
+			*c = changes[0]
+			changes = changes[1:]
+			return nil
+		},
+		CloseFunc: func() error { return nil },
+	}
+}
+
+func TestFollow(t *testing.T) {
+	defer func(delay time.Duration) { followRetryDelay = delay }(followRetryDelay)
+	followRetryDelay = 0
+	errStop := errors.New("stop")
+	missingCheckpoint := func(_ context.Context, _ string, _ map[string]interface{}) (*driver.Document, error) {
+		return nil, errors.Status(StatusNotFound, "missing")
+	}
+	tests := []struct {
+		name         string
+		db           func(*[]string) *DB
+		checkpointID string
+		options      Options
+		handler      func(*Change) error
+		expected     []*Change
+		checkpoints  []string
+		status       int
+		err          string
+	}{
+		{
+			name:   "no checkpoint ID",
+			db:     func(_ *[]string) *DB { return &DB{} },
+			status: StatusBadRequest,
+			err:    "kivik: checkpointID required",
+		},
+		{
+			name: "checkpoint error",
+			db: func(_ *[]string) *DB {
+				return &DB{driverDB: &mock.DB{
+					GetFunc: func(_ context.Context, _ string, _ map[string]interface{}) (*driver.Document, error) {
+						return nil, errors.Status(StatusUnauthorized, "unauthorized")
+					},
+				}}
+			},
+			checkpointID: "foo",
+			status:       StatusUnauthorized,
+			err:          "unauthorized",
+		},
+		{
+			name: "resume from checkpoint until handler error",
+			db: func(saved *[]string) *DB {
+				return &DB{driverDB: &mock.DB{
+					GetFunc: func(_ context.Context, docID string, _ map[string]interface{}) (*driver.Document, error) {
+						if docID != "_local/foo" {
+							return nil, fmt.Errorf("Unexpected checkpoint ID: %s", docID)
+						}
+						return &driver.Document{Body: body(`{"_rev":"0-1","last_seq":"1-abc"}`)}, nil
+					},
+					ChangesFunc: func(_ context.Context, opts map[string]interface{}) (driver.Changes, error) {
+						expected := map[string]interface{}{"feed": "continuous", "since": "1-abc", "include_docs": true}
+						if d := diff.Interface(expected, opts); d != nil {
+							return nil, fmt.Errorf("Unexpected options:\n%s", d)
+						}
+						return testFeed(nil,
+							driver.Change{ID: "a", Seq: "2-abc", Changes: []string{"1-a"}, Doc: []byte(`{"_id":"a"}`)},
+							driver.Change{ID: "b", Seq: "3-abc", Deleted: true},
+						), nil
+					},
+					PutFunc: checkpointSaver(saved),
+				}}
+			},
+			checkpointID: "foo",
+			options:      Options{"include_docs": true},
+			handler: func(c *Change) error {
+				if c.ID == "b" {
+					return errStop
+				}
+				return nil
+			},
+			expected: []*Change{
+				{ID: "a", Seq: "2-abc", Changes: []string{"1-a"}, Doc: []byte(`{"_id":"a"}`)},
+				{ID: "b", Seq: "3-abc", Deleted: true},
+			},
+			checkpoints: []string{"0-1:2-abc"},
+			status:      StatusInternalServerError,
+			err:         "stop",
+		},
+		{
+			name: "reconnect after transient error",
+			db: func(saved *[]string) *DB {
+				var calls int
+				return &DB{driverDB: &mock.DB{
+					GetFunc: missingCheckpoint,
+					ChangesFunc: func(_ context.Context, opts map[string]interface{}) (driver.Changes, error) {
+						calls++
+						switch calls {
+						case 1:
+							if _, ok := opts["since"]; ok {
+								return nil, fmt.Errorf("Unexpected since value: %v", opts["since"])
+							}
+							return testFeed(errors.Status(StatusNetworkError, "connection reset"),
+								driver.Change{ID: "a", Seq: "1-abc"},
+							), nil
+						case 2:
+							return nil, errors.Status(StatusRequestTimeout, "timeout")
+						}
+						if opts["since"] != "1-abc" {
+							return nil, fmt.Errorf("Unexpected since value: %v", opts["since"])
+						}
+						return testFeed(nil, driver.Change{ID: "b", Seq: "2-abc"}), nil
+					},
+					PutFunc: checkpointSaver(saved),
+				}}
+			},
+			checkpointID: "foo",
+			handler: func(c *Change) error {
+				if c.ID == "b" {
+					return errStop
+				}
+				return nil
+			},
+			expected: []*Change{
+				{ID: "a", Seq: "1-abc"},
+				{ID: "b", Seq: "2-abc"},
+			},
+			checkpoints: []string{":1-abc"},
+			status:      StatusInternalServerError,
+			err:         "stop",
+		},
+		{
+			name: "permanent error",
+			db: func(_ *[]string) *DB {
+				return &DB{driverDB: &mock.DB{
+					GetFunc: missingCheckpoint,
+					ChangesFunc: func(_ context.Context, _ map[string]interface{}) (driver.Changes, error) {
+						return nil, errors.Status(StatusBadRequest, "bad request")
+					},
+				}}
+			},
+			checkpointID: "foo",
+			status:       StatusBadRequest,
+			err:          "bad request",
+		},
+		{
+			name: "checkpoint save error",
+			db: func(_ *[]string) *DB {
+				return &DB{driverDB: &mock.DB{
+					GetFunc: missingCheckpoint,
+					ChangesFunc: func(_ context.Context, _ map[string]interface{}) (driver.Changes, error) {
+						return testFeed(nil, driver.Change{ID: "a", Seq: "1-abc"}), nil
+					},
+					PutFunc: func(_ context.Context, _ string, _ interface{}, _ map[string]interface{}) (string, error) {
+						return "", errors.Status(StatusConflict, "conflict")
+					},
+				}}
+			},
+			checkpointID: "foo",
+			expected:     []*Change{{ID: "a", Seq: "1-abc"}},
+			status:       StatusConflict,
+			err:          "conflict",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var checkpoints []string
+			var result []*Change
+			handler := func(c *Change) error {
+				result = append(result, c)
+				if test.handler != nil {
+					return test.handler(c)
+				}
+				return nil
+			}
+			err := test.db(&checkpoints).Follow(context.Background(), test.checkpointID, handler, test.options)
+			if d := diff.Interface(test.expected, result); d != nil {
+				t.Errorf("Unexpected changes:\n%s", d)
+			}
+			if d := diff.Interface(test.checkpoints, checkpoints); d != nil {
+				t.Errorf("Unexpected checkpoints:\n%s", d)
+			}
+			testy.StatusError(t, test.err, test.status, err)
+		})
+	}
+}
+
+func TestFollowCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	db := &DB{driverDB: &mock.DB{
+		GetFunc: func(_ context.Context, _ string, _ map[string]interface{}) (*driver.Document, error) {
+			return nil, errors.Status(StatusNotFound, "missing")
+		},
+		ChangesFunc: func(_ context.Context, _ map[string]interface{}) (driver.Changes, error) {
+			return nil, errors.Status(StatusNetworkError, "connection refused")
+		},
+	}}
+	err := db.Follow(ctx, "foo", func(_ *Change) error { return nil })
+	if err != context.Canceled {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+// checkpointSaver returns a PutFunc which records each saved checkpoint as
+// "rev:last_seq".
+func checkpointSaver(saved *[]string) func(context.Context, string, interface{}, map[string]interface{}) (string, error) {
+	return func(_ context.Context, docID string, doc interface{}, _ map[string]interface{}) (string, error) {
+		if docID != "_local/foo" {
+			return "", fmt.Errorf("Unexpected checkpoint ID: %s", docID)
+		}
+		cp := doc.(*checkpoint)
+		*saved = append(*saved, cp.Rev+":"+cp.LastSeq)
+		return fmt.Sprintf("0-%d", len(*saved)+1), nil
+	}
+}
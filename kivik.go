@@ -3,6 +3,8 @@ package kivik
 import (
 	"context"
 	"encoding/json"
+	"net/url"
+	"sync"
 
 	"github.com/imdario/mergo"
 
@@ -15,9 +17,14 @@ type Client struct {
 	dsn          string
 	driverName   string
 	driverClient driver.Client
+
+	mu     sync.RWMutex
+	closed bool
 }
 
 // Options is a collection of options. The keys and values are backend specific.
+// Unless a method's documentation says otherwise, options are passed through
+// to the driver unaltered.
 type Options map[string]interface{}
 
 func mergeOptions(otherOpts ...Options) (Options, error) {
@@ -55,17 +62,50 @@ func (c *Client) Driver() string {
 	return c.driverName
 }
 
-// DSN returns the data source name used to connect this client.
+// DSN returns the data source name used to connect this client. It may
+// contain credentials; use RedactDSN before logging it.
 func (c *Client) DSN() string {
 	return c.dsn
 }
 
+// redacted replaces credentials removed by RedactDSN.
+const redacted = "xxxx"
+
+// RedactDSN returns dsn with any username and password replaced by "xxxx",
+// making it safe to log. DSNs which are not URLs, such as file paths, are
+// returned unchanged. A DSN which cannot be parsed is replaced entirely, as
+// it may still contain credentials.
+func RedactDSN(dsn string) string {
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return redacted
+	}
+	if u.User == nil {
+		return dsn
+	}
+	if _, ok := u.User.Password(); ok {
+		u.User = url.UserPassword(redacted, redacted)
+	} else {
+		u.User = url.User(redacted)
+	}
+	return u.String()
+}
+
 // Version represents a server version response.
 type Version struct {
 	// Version is the version number reported by the server or backend.
 	Version string
 	// Vendor is the vendor string reported by the server or backend.
 	Vendor string
+	// Features is a list of enabled, optional features.  This was added in
+	// CouchDB 2.1.0, and can be expected to be empty for older versions.
+	Features []string
+	// UUID is the unique identifier of the server instance, as reported by
+	// CouchDB 2.x.
+	UUID string
+	// GitSHA is the git commit from which the server was built, as reported by
+	// CouchDB 2.x.
+	GitSHA string
 	// RawResponse is the raw response body returned by the server, useful if
 	// you need additional backend-specific information.
 	//
@@ -76,6 +116,9 @@ type Version struct {
 
 // Version returns version and vendor info about the backend.
 func (c *Client) Version(ctx context.Context) (*Version, error) {
+	if err := c.checkClosed(); err != nil {
+		return nil, err
+	}
 	ver, err := c.driverClient.Version(ctx)
 	if err != nil {
 		return nil, err
@@ -83,13 +126,63 @@ func (c *Client) Version(ctx context.Context) (*Version, error) {
 	return &Version{
 		Version:     ver.Version,
 		Vendor:      ver.Vendor,
+		Features:    ver.Features,
+		UUID:        ver.UUID,
+		GitSHA:      ver.GitSHA,
 		RawResponse: ver.RawResponse,
 	}, nil
 }
 
+// Close cleans up any resources used by the client, such as idle
+// connections. Once Close has been called, subsequent calls to the Client's
+// methods return an error with status StatusBadAPICall. If the driver does not
+// support the ClientCloser interface, the driver client is left untouched.
+func (c *Client) Close(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return errClientClosed
+	}
+	c.closed = true
+	if closer, ok := c.driverClient.(driver.ClientCloser); ok {
+		return closer.Close(ctx)
+	}
+	return nil
+}
+
+var errClientClosed = errors.Status(StatusBadAPICall, "kivik: client closed")
+
+// checkClosed returns an error if Close has been called on the client.
+func (c *Client) checkClosed() error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.closed {
+		return errClientClosed
+	}
+	return nil
+}
+
+// Ping returns true if the database server is reachable and responding. The
+// context's deadline is honored, making it suitable for health checks with
+// short timeouts. If the driver does not support the Pinger interface, Ping
+// calls Version and discards the result.
+func (c *Client) Ping(ctx context.Context) (bool, error) {
+	if err := c.checkClosed(); err != nil {
+		return false, err
+	}
+	if pinger, ok := c.driverClient.(driver.Pinger); ok {
+		return pinger.Ping(ctx)
+	}
+	_, err := c.driverClient.Version(ctx)
+	return err == nil, err
+}
+
 // DB returns a handle to the requested database. Any options parameters
 // passed are merged, with later values taking precidence.
 func (c *Client) DB(ctx context.Context, dbName string, options ...Options) (*DB, error) {
+	if err := c.checkClosed(); err != nil {
+		return nil, err
+	}
 	opts, err := mergeOptions(options...)
 	if err != nil {
 		return nil, err
@@ -102,8 +195,13 @@ func (c *Client) DB(ctx context.Context, dbName string, options ...Options) (*DB
 	}, err
 }
 
-// AllDBs returns a list of all databases.
+// AllDBs returns a list of all databases. With CouchDB, the startkey, endkey,
+// limit and skip options may be used to page through the list. With no
+// options, all databases are returned.
 func (c *Client) AllDBs(ctx context.Context, options ...Options) ([]string, error) {
+	if err := c.checkClosed(); err != nil {
+		return nil, err
+	}
 	opts, err := mergeOptions(options...)
 	if err != nil {
 		return nil, err
@@ -113,6 +211,9 @@ func (c *Client) AllDBs(ctx context.Context, options ...Options) ([]string, erro
 
 // DBExists returns true if the specified database exists.
 func (c *Client) DBExists(ctx context.Context, dbName string, options ...Options) (bool, error) {
+	if err := c.checkClosed(); err != nil {
+		return false, err
+	}
 	opts, err := mergeOptions(options...)
 	if err != nil {
 		return false, err
@@ -120,8 +221,16 @@ func (c *Client) DBExists(ctx context.Context, dbName string, options ...Options
 	return c.driverClient.DBExists(ctx, dbName, opts)
 }
 
-// CreateDB creates a DB of the requested name.
+// CreateDB creates a DB of the requested name. Server-specific creation
+// parameters, such as q, n or partitioned for CouchDB, may be provided as
+// options.
+//
+// If the database already exists, CouchDB responds with a 412 (Precondition
+// Failed) status, available via StatusCode.
 func (c *Client) CreateDB(ctx context.Context, dbName string, options ...Options) (*DB, error) {
+	if err := c.checkClosed(); err != nil {
+		return nil, err
+	}
 	opts, err := mergeOptions(options...)
 	if err != nil {
 		return nil, err
@@ -134,6 +243,9 @@ func (c *Client) CreateDB(ctx context.Context, dbName string, options ...Options
 
 // DestroyDB deletes the requested DB.
 func (c *Client) DestroyDB(ctx context.Context, dbName string, options ...Options) error {
+	if err := c.checkClosed(); err != nil {
+		return err
+	}
 	opts, err := mergeOptions(options...)
 	if err != nil {
 		return err
@@ -145,6 +257,9 @@ func (c *Client) DestroyDB(ctx context.Context, dbName string, options ...Option
 // is driver-specific. If the driver does not understand the authenticator, an
 // error will be returned.
 func (c *Client) Authenticate(ctx context.Context, a interface{}) error {
+	if err := c.checkClosed(); err != nil {
+		return err
+	}
 	if auth, ok := c.driverClient.(driver.Authenticator); ok {
 		return auth.Authenticate(ctx, a)
 	}
@@ -155,8 +270,17 @@ func missingArg(arg string) error {
 	return errors.Statusf(StatusBadRequest, "kivik: %s required", arg)
 }
 
-// DBsStats returns database statistics about one or more databases.
+// DBsStats returns database statistics about one or more databases. The
+// results are returned in the same order as dbnames, with a nil entry for any
+// database which does not exist.
+//
+// If the driver or server does not support fetching stats for multiple
+// databases in a single request (CouchDB 2.2.0's /_dbs_info), Stats is called
+// for each database in turn.
 func (c *Client) DBsStats(ctx context.Context, dbnames []string) ([]*DBStats, error) {
+	if err := c.checkClosed(); err != nil {
+		return nil, err
+	}
 	dbstats, err := c.nativeDBsStats(ctx, dbnames)
 	switch StatusCode(err) {
 	case StatusNotFound, StatusNotImplemented:
@@ -173,7 +297,10 @@ func (c *Client) fallbackDBsStats(ctx context.Context, dbnames []string) ([]*DBS
 			return nil, err
 		}
 		stat, err := db.Stats(ctx)
-		if err != nil {
+		switch {
+		case StatusCode(err) == StatusNotFound:
+			continue
+		case err != nil:
 			return nil, err
 		}
 		dbstats[i] = stat
@@ -192,6 +319,9 @@ func (c *Client) nativeDBsStats(ctx context.Context, dbnames []string) ([]*DBSta
 	}
 	dbstats := make([]*DBStats, len(stats))
 	for i, stat := range stats {
+		if stat == nil {
+			continue
+		}
 		dbstats[i] = driverStats2kivikStats(stat)
 	}
 	return dbstats, nil
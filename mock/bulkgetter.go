@@ -0,0 +1,20 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/go-kivik/kivik/driver"
+)
+
+// BulkGetter mocks a driver.DB and driver.BulkGetter
+type BulkGetter struct {
+	*DB
+	BulkGetFunc func(ctx context.Context, docs []driver.BulkGetReference, options map[string]interface{}) (driver.Rows, error)
+}
+
+var _ driver.BulkGetter = &BulkGetter{}
+
+// BulkGet calls db.BulkGetFunc
+func (db *BulkGetter) BulkGet(ctx context.Context, docs []driver.BulkGetReference, options map[string]interface{}) (driver.Rows, error) {
+	return db.BulkGetFunc(ctx, docs, options)
+}
@@ -19,3 +19,16 @@ func (c *Changes) Next(change *driver.Change) error {
 func (c *Changes) Close() error {
 	return c.CloseFunc()
 }
+
+// LastSeqer wraps driver.LastSeqer
+type LastSeqer struct {
+	*Changes
+	LastSeqFunc func() string
+}
+
+var _ driver.LastSeqer = &LastSeqer{}
+
+// LastSeq calls c.LastSeqFunc
+func (c *LastSeqer) LastSeq() string {
+	return c.LastSeqFunc()
+}
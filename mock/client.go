@@ -69,6 +69,32 @@ func (c *ClientReplicator) Replicate(ctx context.Context, target, source string,
 	return c.ReplicateFunc(ctx, target, source, opts)
 }
 
+// ClientCloser mocks driver.Client and driver.ClientCloser
+type ClientCloser struct {
+	*Client
+	CloseFunc func(context.Context) error
+}
+
+var _ driver.ClientCloser = &ClientCloser{}
+
+// Close calls c.CloseFunc
+func (c *ClientCloser) Close(ctx context.Context) error {
+	return c.CloseFunc(ctx)
+}
+
+// Pinger mocks driver.Client and driver.Pinger
+type Pinger struct {
+	*Client
+	PingFunc func(context.Context) (bool, error)
+}
+
+var _ driver.Pinger = &Pinger{}
+
+// Ping calls c.PingFunc
+func (c *Pinger) Ping(ctx context.Context) (bool, error) {
+	return c.PingFunc(ctx)
+}
+
 // Authenticator mocks driver.Client and driver.Authenticator
 type Authenticator struct {
 	*Client
@@ -85,14 +111,14 @@ func (c *Authenticator) Authenticate(ctx context.Context, a interface{}) error {
 // DBUpdater mocks driver.Client and driver.DBUpdater
 type DBUpdater struct {
 	*Client
-	DBUpdatesFunc func() (driver.DBUpdates, error)
+	DBUpdatesFunc func(context.Context) (driver.DBUpdates, error)
 }
 
 var _ driver.DBUpdater = &DBUpdater{}
 
 // DBUpdates calls c.DBUpdatesFunc
-func (c *DBUpdater) DBUpdates() (driver.DBUpdates, error) {
-	return c.DBUpdatesFunc()
+func (c *DBUpdater) DBUpdates(ctx context.Context) (driver.DBUpdates, error) {
+	return c.DBUpdatesFunc(ctx)
 }
 
 // DBsStatser mocks driver.Client and driver.DBsStatser
@@ -107,3 +133,98 @@ var _ driver.DBsStatser = &DBsStatser{}
 func (c *DBsStatser) DBsStats(ctx context.Context, dbnames []string) ([]*driver.DBStats, error) {
 	return c.DBsStatsFunc(ctx, dbnames)
 }
+
+// Configer mocks driver.Client and driver.Configer
+type Configer struct {
+	*Client
+	ConfigFunc            func(context.Context, string) (driver.Config, error)
+	ConfigSectionFunc     func(context.Context, string, string) (driver.ConfigSection, error)
+	ConfigValueFunc       func(context.Context, string, string, string) (string, error)
+	SetConfigValueFunc    func(context.Context, string, string, string, string) (string, error)
+	DeleteConfigValueFunc func(context.Context, string, string, string) (string, error)
+}
+
+var _ driver.Configer = &Configer{}
+
+// Config calls c.ConfigFunc
+func (c *Configer) Config(ctx context.Context, node string) (driver.Config, error) {
+	return c.ConfigFunc(ctx, node)
+}
+
+// ConfigSection calls c.ConfigSectionFunc
+func (c *Configer) ConfigSection(ctx context.Context, node, section string) (driver.ConfigSection, error) {
+	return c.ConfigSectionFunc(ctx, node, section)
+}
+
+// ConfigValue calls c.ConfigValueFunc
+func (c *Configer) ConfigValue(ctx context.Context, node, section, key string) (string, error) {
+	return c.ConfigValueFunc(ctx, node, section, key)
+}
+
+// SetConfigValue calls c.SetConfigValueFunc
+func (c *Configer) SetConfigValue(ctx context.Context, node, section, key, value string) (string, error) {
+	return c.SetConfigValueFunc(ctx, node, section, key, value)
+}
+
+// DeleteConfigValue calls c.DeleteConfigValueFunc
+func (c *Configer) DeleteConfigValue(ctx context.Context, node, section, key string) (string, error) {
+	return c.DeleteConfigValueFunc(ctx, node, section, key)
+}
+
+// Cluster mocks driver.Client and driver.Cluster
+type Cluster struct {
+	*Client
+	MembershipFunc func(context.Context) (*driver.ClusterMembership, error)
+}
+
+var _ driver.Cluster = &Cluster{}
+
+// Membership calls c.MembershipFunc
+func (c *Cluster) Membership(ctx context.Context) (*driver.ClusterMembership, error) {
+	return c.MembershipFunc(ctx)
+}
+
+// ActiveTasker mocks driver.Client and driver.ActiveTasker
+type ActiveTasker struct {
+	*Client
+	ActiveTasksFunc func(context.Context) ([]*driver.Task, error)
+}
+
+var _ driver.ActiveTasker = &ActiveTasker{}
+
+// ActiveTasks calls c.ActiveTasksFunc
+func (c *ActiveTasker) ActiveTasks(ctx context.Context) ([]*driver.Task, error) {
+	return c.ActiveTasksFunc(ctx)
+}
+
+// Scheduler mocks driver.Client and driver.Scheduler
+type Scheduler struct {
+	*Client
+	SchedulerJobsFunc func(context.Context, map[string]interface{}) ([]*driver.SchedulerJob, error)
+	SchedulerDocsFunc func(context.Context, map[string]interface{}) ([]*driver.SchedulerDoc, error)
+}
+
+var _ driver.Scheduler = &Scheduler{}
+
+// SchedulerJobs calls c.SchedulerJobsFunc
+func (c *Scheduler) SchedulerJobs(ctx context.Context, opts map[string]interface{}) ([]*driver.SchedulerJob, error) {
+	return c.SchedulerJobsFunc(ctx, opts)
+}
+
+// SchedulerDocs calls c.SchedulerDocsFunc
+func (c *Scheduler) SchedulerDocs(ctx context.Context, opts map[string]interface{}) ([]*driver.SchedulerDoc, error) {
+	return c.SchedulerDocsFunc(ctx, opts)
+}
+
+// UUIDer mocks driver.Client and driver.UUIDer
+type UUIDer struct {
+	*Client
+	UUIDsFunc func(context.Context, int) ([]string, error)
+}
+
+var _ driver.UUIDer = &UUIDer{}
+
+// UUIDs calls c.UUIDsFunc
+func (c *UUIDer) UUIDs(ctx context.Context, count int) ([]string, error) {
+	return c.UUIDsFunc(ctx, count)
+}
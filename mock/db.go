@@ -2,6 +2,7 @@ package mock
 
 import (
 	"context"
+	"io"
 
 	"github.com/go-kivik/kivik/driver"
 )
@@ -199,6 +200,19 @@ func (db *AttachmentMetaGetter) GetAttachmentMeta(ctx context.Context, docID, re
 	return db.GetAttachmentMetaFunc(ctx, docID, rev, filename, options)
 }
 
+// AttachmentRangeGetter mocks a driver.DB and driver.AttachmentRangeGetter
+type AttachmentRangeGetter struct {
+	*DB
+	GetAttachmentRangeFunc func(ctx context.Context, docID, rev, filename string, start, end int64, options map[string]interface{}) (*driver.Attachment, error)
+}
+
+var _ driver.AttachmentRangeGetter = &AttachmentRangeGetter{}
+
+// GetAttachmentRange calls db.GetAttachmentRangeFunc
+func (db *AttachmentRangeGetter) GetAttachmentRange(ctx context.Context, docID, rev, filename string, start, end int64, options map[string]interface{}) (*driver.Attachment, error) {
+	return db.GetAttachmentRangeFunc(ctx, docID, rev, filename, start, end, options)
+}
+
 // DesignDocer mocks a driver.DB and driver.DesignDocer
 type DesignDocer struct {
 	*DB
@@ -237,3 +251,113 @@ var _ driver.Purger = &Purger{}
 func (db *Purger) Purge(ctx context.Context, docMap map[string][]string) (*driver.PurgeResult, error) {
 	return db.PurgeFunc(ctx, docMap)
 }
+
+// RevsDiffer mocks a driver.DB and driver.RevsDiffer
+type RevsDiffer struct {
+	*DB
+	RevsDiffFunc func(context.Context, map[string][]string) (map[string]driver.RevDiff, error)
+}
+
+var _ driver.RevsDiffer = &RevsDiffer{}
+
+// RevsDiff calls db.RevsDiffFunc
+func (db *RevsDiffer) RevsDiff(ctx context.Context, revMap map[string][]string) (map[string]driver.RevDiff, error) {
+	return db.RevsDiffFunc(ctx, revMap)
+}
+
+// RevsLimiter mocks a driver.DB and driver.RevsLimiter
+type RevsLimiter struct {
+	*DB
+	RevsLimitFunc    func(context.Context) (int, error)
+	SetRevsLimitFunc func(context.Context, int) error
+}
+
+var _ driver.RevsLimiter = &RevsLimiter{}
+
+// RevsLimit calls db.RevsLimitFunc
+func (db *RevsLimiter) RevsLimit(ctx context.Context) (int, error) {
+	return db.RevsLimitFunc(ctx)
+}
+
+// SetRevsLimit calls db.SetRevsLimitFunc
+func (db *RevsLimiter) SetRevsLimit(ctx context.Context, limit int) error {
+	return db.SetRevsLimitFunc(ctx, limit)
+}
+
+// Shower mocks a driver.DB and driver.Shower
+type Shower struct {
+	*DB
+	ShowFunc func(context.Context, string, string, string, map[string]interface{}) (string, io.ReadCloser, error)
+}
+
+var _ driver.Shower = &Shower{}
+
+// Show calls db.ShowFunc
+func (db *Shower) Show(ctx context.Context, ddoc, show, docID string, options map[string]interface{}) (string, io.ReadCloser, error) {
+	return db.ShowFunc(ctx, ddoc, show, docID, options)
+}
+
+// Lister mocks a driver.DB and driver.Lister
+type Lister struct {
+	*DB
+	ListFunc func(context.Context, string, string, string, map[string]interface{}) (string, io.ReadCloser, error)
+}
+
+var _ driver.Lister = &Lister{}
+
+// List calls db.ListFunc
+func (db *Lister) List(ctx context.Context, ddoc, list, view string, options map[string]interface{}) (string, io.ReadCloser, error) {
+	return db.ListFunc(ctx, ddoc, list, view, options)
+}
+
+// UpdateFuncer mocks a driver.DB and driver.UpdateFuncer
+type UpdateFuncer struct {
+	*DB
+	UpdateFuncFunc func(context.Context, string, string, string, io.Reader, string, map[string]interface{}) (string, []byte, error)
+}
+
+var _ driver.UpdateFuncer = &UpdateFuncer{}
+
+// UpdateFunc calls db.UpdateFuncFunc
+func (db *UpdateFuncer) UpdateFunc(ctx context.Context, ddoc, update, docID string, body io.Reader, contentType string, options map[string]interface{}) (string, []byte, error) {
+	return db.UpdateFuncFunc(ctx, ddoc, update, docID, body, contentType, options)
+}
+
+// DesignInfoer mocks a driver.DB and driver.DesignInfoer
+type DesignInfoer struct {
+	*DB
+	DesignInfoFunc func(context.Context, string) (*driver.DesignInfo, error)
+}
+
+var _ driver.DesignInfoer = &DesignInfoer{}
+
+// DesignInfo calls db.DesignInfoFunc
+func (db *DesignInfoer) DesignInfo(ctx context.Context, ddoc string) (*driver.DesignInfo, error) {
+	return db.DesignInfoFunc(ctx, ddoc)
+}
+
+// Searcher mocks a driver.DB and driver.Searcher
+type Searcher struct {
+	*DB
+	SearchFunc func(context.Context, string, string, map[string]interface{}) (driver.Rows, error)
+}
+
+var _ driver.Searcher = &Searcher{}
+
+// Search calls db.SearchFunc
+func (db *Searcher) Search(ctx context.Context, ddoc, index string, options map[string]interface{}) (driver.Rows, error) {
+	return db.SearchFunc(ctx, ddoc, index, options)
+}
+
+// GeoQuerier mocks a driver.DB and driver.GeoQuerier
+type GeoQuerier struct {
+	*DB
+	GeoFunc func(context.Context, string, string, map[string]interface{}) (driver.Rows, error)
+}
+
+var _ driver.GeoQuerier = &GeoQuerier{}
+
+// Geo calls db.GeoFunc
+func (db *GeoQuerier) Geo(ctx context.Context, ddoc, index string, options map[string]interface{}) (driver.Rows, error) {
+	return db.GeoFunc(ctx, ddoc, index, options)
+}
@@ -65,3 +65,22 @@ var _ driver.Bookmarker = &Bookmarker{}
 func (r *Bookmarker) Bookmark() string {
 	return r.BookmarkFunc()
 }
+
+// Faceter wraps driver.Faceter
+type Faceter struct {
+	*Rows
+	CountsFunc func() map[string]driver.Facets
+	RangesFunc func() map[string]driver.Facets
+}
+
+var _ driver.Faceter = &Faceter{}
+
+// Counts calls r.CountsFunc
+func (r *Faceter) Counts() map[string]driver.Facets {
+	return r.CountsFunc()
+}
+
+// Ranges calls r.RangesFunc
+func (r *Faceter) Ranges() map[string]driver.Facets {
+	return r.RangesFunc()
+}
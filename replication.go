@@ -143,6 +143,9 @@ func (r *Replication) Update(ctx context.Context) error {
 // database. Options are in the same format as to AllDocs(), except that
 // "conflicts" and "update_seq" are ignored.
 func (c *Client) GetReplications(ctx context.Context, options ...Options) ([]*Replication, error) {
+	if err := c.checkClosed(); err != nil {
+		return nil, err
+	}
 	if replicator, ok := c.driverClient.(driver.ClientReplicator); ok {
 		opts, err := mergeOptions(options...)
 		if err != nil {
@@ -163,6 +166,9 @@ func (c *Client) GetReplications(ctx context.Context, options ...Options) ([]*Re
 
 // Replicate initiates a replication from source to target.
 func (c *Client) Replicate(ctx context.Context, targetDSN, sourceDSN string, options ...Options) (*Replication, error) {
+	if err := c.checkClosed(); err != nil {
+		return nil, err
+	}
 	if replicator, ok := c.driverClient.(driver.ClientReplicator); ok {
 		opts, err := mergeOptions(options...)
 		if err != nil {
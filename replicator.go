@@ -0,0 +1,124 @@
+package kivik
+
+import (
+	"context"
+	"fmt"
+)
+
+// ReplicationOptions controls the behavior of ReplicateLocal.
+type ReplicationOptions struct {
+	// ID is the name of the checkpoint document, stored as _local/{ID} in the
+	// target database. It defaults to "kivik-{source}-{target}", using the
+	// database names, which should be overridden when replicating between
+	// identically-named databases on different servers.
+	ID string
+	// Continuous, when true, keeps the replication running, following the
+	// source's changes feed until the context is cancelled.
+	Continuous bool
+}
+
+// ReplicationResult reports the progress of a ReplicateLocal run.
+type ReplicationResult struct {
+	// DocsRead is the number of document revisions read from the source.
+	DocsRead int64
+	// DocsWritten is the number of document revisions written to the target.
+	DocsWritten int64
+	// MissingChecked is the number of revisions checked against the target.
+	MissingChecked int64
+	// MissingFound is the number of revisions found missing from the target.
+	MissingFound int64
+	// LastSeq is the source sequence up to which replication completed.
+	LastSeq string
+}
+
+// ReplicateLocal replicates the documents from source to target, using the
+// CouchDB replication protocol on the client side, so that databases using
+// any combination of drivers may be replicated.
+//
+// Changes are read from the source, starting from the sequence stored in the
+// target's checkpoint document, compared with the target using RevsDiff, and
+// each missing revision is copied with its revision history, through Get and
+// Put with new_edits=false. If the target does not support RevsDiff, all
+// revisions are treated as missing, which is slower but still correct, as
+// storing an existing revision has no effect. The checkpoint is stored
+// periodically, and when ReplicateLocal returns, so an interrupted replication
+// resumes close to where it left off.
+//
+// Without the Continuous option, ReplicateLocal returns once the source's
+// changes have been exhausted. With it, ReplicateLocal returns when ctx is
+// cancelled, along with the context's error. In either case, the progress
+// made so far is reported.
+func ReplicateLocal(ctx context.Context, target, source *DB, opts ReplicationOptions) (*ReplicationResult, error) {
+	id := opts.ID
+	if id == "" {
+		id = fmt.Sprintf("kivik-%s-%s", source.Name(), target.Name())
+	}
+	cp, err := loadCheckpoint(ctx, target, id)
+	if err != nil {
+		return nil, err
+	}
+	result := &ReplicationResult{LastSeq: cp.LastSeq}
+	changesOpts := Options{"style": "all_docs"}
+	if cp.LastSeq != "" {
+		changesOpts["since"] = cp.LastSeq
+	}
+	if opts.Continuous {
+		changesOpts["feed"] = "continuous"
+	}
+	changes, err := source.Changes(ctx, changesOpts)
+	if err != nil {
+		return result, err
+	}
+	defer changes.Close() // nolint: errcheck
+	err = replicateChanges(ctx, target, source, changes, cp, result)
+	if flushErr := cp.flush(ctx); err == nil {
+		err = flushErr
+	}
+	if err != nil {
+		return result, err
+	}
+	return result, ctx.Err()
+}
+
+// replicateChanges copies the missing revisions for each change read from
+// changes, recording progress in cp.
+func replicateChanges(ctx context.Context, target, source *DB, changes *Changes, cp *checkpoint, result *ReplicationResult) error {
+	for changes.Next() {
+		if err := replicateChange(ctx, target, source, changes.ID(), changes.Changes(), result); err != nil {
+			return err
+		}
+		if err := cp.update(ctx, changes.Seq()); err != nil {
+			return err
+		}
+		result.LastSeq = cp.LastSeq
+	}
+	return changes.Err()
+}
+
+// replicateChange copies the revisions of docID missing from target.
+func replicateChange(ctx context.Context, target, source *DB, docID string, revs []string, result *ReplicationResult) error {
+	result.MissingChecked += int64(len(revs))
+	missing := revs
+	diff, err := target.RevsDiff(ctx, map[string][]string{docID: revs})
+	switch {
+	case StatusCode(err) == StatusNotImplemented:
+	case err != nil:
+		return err
+	default:
+		missing = diff[docID].Missing
+	}
+	result.MissingFound += int64(len(missing))
+	for _, rev := range missing {
+		var doc map[string]interface{}
+		err := source.Get(ctx, docID, Options{"rev": rev, "revs": true, "attachments": true}).ScanDoc(&doc)
+		if err != nil {
+			return err
+		}
+		result.DocsRead++
+		if _, err := target.Put(ctx, docID, doc, Options{"new_edits": false}); err != nil {
+			return err
+		}
+		result.DocsWritten++
+	}
+	return nil
+}
@@ -0,0 +1,204 @@
+package kivik
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/flimzy/diff"
+	"github.com/flimzy/testy"
+	"github.com/go-kivik/kivik/driver"
+	"github.com/go-kivik/kivik/errors"
+	"github.com/go-kivik/kivik/mock"
+)
+
+// replicationTarget is a fake target database, which records all writes.
+type replicationTarget struct {
+	checkpoint string
+	missing    map[string][]string
+	noRevsDiff bool
+	writes     []string
+}
+
+func (rt *replicationTarget) db() *DB {
+	db := &mock.DB{
+		GetFunc: func(_ context.Context, docID string, _ map[string]interface{}) (*driver.Document, error) {
+			if docID != "_local/rep1" {
+				return nil, fmt.Errorf("Unexpected checkpoint ID: %s", docID)
+			}
+			if rt.checkpoint == "" {
+				return nil, errors.Status(StatusNotFound, "missing")
+			}
+			return &driver.Document{Body: body(rt.checkpoint)}, nil
+		},
+		PutFunc: func(_ context.Context, docID string, doc interface{}, opts map[string]interface{}) (string, error) {
+			switch d := doc.(type) {
+			case *checkpoint:
+				rt.writes = append(rt.writes, fmt.Sprintf("%s checkpoint %s", docID, d.LastSeq))
+			case map[string]interface{}:
+				if opts["new_edits"] != false {
+					return "", fmt.Errorf("Unexpected options: %v", opts)
+				}
+				rt.writes = append(rt.writes, fmt.Sprintf("%s %s", docID, d["_rev"]))
+			default:
+				return "", fmt.Errorf("Unexpected doc type: %T", doc)
+			}
+			return "1-xxx", nil
+		},
+	}
+	if rt.noRevsDiff {
+		return &DB{name: "target", driverDB: db}
+	}
+	return &DB{name: "target", driverDB: &mock.RevsDiffer{
+		DB: db,
+		RevsDiffFunc: func(_ context.Context, revMap map[string][]string) (map[string]driver.RevDiff, error) {
+			result := make(map[string]driver.RevDiff)
+			for docID := range revMap {
+				if missing, ok := rt.missing[docID]; ok {
+					result[docID] = driver.RevDiff{Missing: missing}
+				}
+			}
+			return result, nil
+		},
+	}}
+}
+
+func replicationSource(expectedOpts map[string]interface{}, feedErr error, changes ...driver.Change) *DB {
+	return &DB{name: "source", driverDB: &mock.DB{
+		ChangesFunc: func(_ context.Context, opts map[string]interface{}) (driver.Changes, error) {
+			if d := diff.Interface(expectedOpts, opts); d != nil {
+				return nil, fmt.Errorf("Unexpected options:\n%s", d)
+			}
+			return testFeed(feedErr, changes...), nil
+		},
+		GetFunc: func(_ context.Context, docID string, opts map[string]interface{}) (*driver.Document, error) {
+			if docID == "broken" {
+				return nil, errors.Status(StatusForbidden, "forbidden")
+			}
+			return &driver.Document{Body: body(fmt.Sprintf(`{"_id":%q,"_rev":%q}`, docID, opts["rev"]))}, nil
+		},
+	}}
+}
+
+func TestReplicateLocal(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   *replicationTarget
+		source   *DB
+		opts     ReplicationOptions
+		expected *ReplicationResult
+		writes   []string
+		status   int
+		err      string
+	}{
+		{
+			name:   "checkpoint error",
+			target: &replicationTarget{checkpoint: "invalid json"},
+			opts:   ReplicationOptions{ID: "rep1"},
+			status: StatusBadResponse,
+			err:    "invalid character 'i' looking for beginning of value",
+		},
+		{
+			name:   "only missing revisions",
+			target: &replicationTarget{missing: map[string][]string{"a": {"2-a"}}},
+			source: replicationSource(map[string]interface{}{"style": "all_docs"}, nil,
+				driver.Change{ID: "a", Seq: "1", Changes: []string{"1-a", "2-a"}},
+				driver.Change{ID: "b", Seq: "2", Changes: []string{"1-b"}},
+			),
+			opts: ReplicationOptions{ID: "rep1"},
+			expected: &ReplicationResult{
+				DocsRead:       1,
+				DocsWritten:    1,
+				MissingChecked: 3,
+				MissingFound:   1,
+				LastSeq:        "2",
+			},
+			writes: []string{
+				"a 2-a",
+				"_local/rep1 checkpoint 2",
+			},
+		},
+		{
+			name: "target without RevsDiff",
+			target: &replicationTarget{
+				checkpoint: `{"_rev":"0-1","last_seq":"5"}`,
+				noRevsDiff: true,
+			},
+			source: replicationSource(map[string]interface{}{"style": "all_docs", "since": "5", "feed": "continuous"}, nil,
+				driver.Change{ID: "a", Seq: "6", Changes: []string{"1-a"}},
+			),
+			opts: ReplicationOptions{ID: "rep1", Continuous: true},
+			expected: &ReplicationResult{
+				DocsRead:       1,
+				DocsWritten:    1,
+				MissingChecked: 1,
+				MissingFound:   1,
+				LastSeq:        "6",
+			},
+			writes: []string{
+				"a 1-a",
+				"_local/rep1 checkpoint 6",
+			},
+		},
+		{
+			name:   "source read error",
+			target: &replicationTarget{noRevsDiff: true},
+			source: replicationSource(map[string]interface{}{"style": "all_docs"}, nil,
+				driver.Change{ID: "a", Seq: "1", Changes: []string{"1-a"}},
+				driver.Change{ID: "broken", Seq: "2", Changes: []string{"1-b"}},
+			),
+			opts: ReplicationOptions{ID: "rep1"},
+			expected: &ReplicationResult{
+				DocsRead:       1,
+				DocsWritten:    1,
+				MissingChecked: 2,
+				MissingFound:   2,
+				LastSeq:        "1",
+			},
+			writes: []string{
+				"a 1-a",
+				"_local/rep1 checkpoint 1",
+			},
+			status: StatusForbidden,
+			err:    "forbidden",
+		},
+		{
+			name:   "feed error",
+			target: &replicationTarget{},
+			source: replicationSource(map[string]interface{}{"style": "all_docs"}, errors.Status(StatusNetworkError, "connection reset")),
+			opts:   ReplicationOptions{ID: "rep1"},
+			expected: &ReplicationResult{
+				LastSeq: "",
+			},
+			status: StatusNetworkError,
+			err:    "connection reset",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := ReplicateLocal(context.Background(), test.target.db(), test.source, test.opts)
+			if d := diff.Interface(test.expected, result); d != nil {
+				t.Errorf("Unexpected result:\n%s", d)
+			}
+			if d := diff.Interface(test.writes, test.target.writes); d != nil {
+				t.Errorf("Unexpected writes:\n%s", d)
+			}
+			testy.StatusError(t, test.err, test.status, err)
+		})
+	}
+}
+
+func TestReplicateLocalDefaultID(t *testing.T) {
+	var checkpointID string
+	target := &DB{name: "bar", driverDB: &mock.DB{
+		GetFunc: func(_ context.Context, docID string, _ map[string]interface{}) (*driver.Document, error) {
+			checkpointID = docID
+			return nil, errors.Status(StatusUnauthorized, "unauthorized")
+		},
+	}}
+	_, err := ReplicateLocal(context.Background(), target, &DB{name: "foo"}, ReplicationOptions{})
+	if checkpointID != "_local/kivik-foo-bar" {
+		t.Errorf("Unexpected checkpoint ID: %s", checkpointID)
+	}
+	testy.Error(t, "unauthorized", err)
+}
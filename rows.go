@@ -8,7 +8,19 @@ import (
 	"github.com/go-kivik/kivik/errors"
 )
 
-// Rows is an iterator over a a multi-value query.
+// Rows is an iterator over a a multi-value query. Callers should always close
+// Rows, even when breaking out of the loop early, and check Err once Next
+// returns false:
+//
+//  rows, err := db.AllDocs(ctx)
+//  if err != nil {
+//      return err
+//  }
+//  defer rows.Close() // nolint: errcheck
+//  for rows.Next() {
+//      // Process the current row
+//  }
+//  return rows.Err()
 type Rows struct {
 	*iter
 	rowsi driver.Rows
@@ -103,10 +115,10 @@ func (r *Rows) ScanKey(dest interface{}) error {
 	if err != nil {
 		return err
 	}
+	defer runlock()
 	if err := r.curVal.(*driver.Row).Error; err != nil {
 		return err
 	}
-	defer runlock()
 	return scan(dest, r.curVal.(*driver.Row).Key)
 }
 
@@ -166,11 +178,49 @@ func (r *Rows) Warning() string {
 
 // Bookmark returns the paging bookmark, if one was provided with the result
 // set. This is intended for use with the Mango /_find interface, with CouchDB
-// 2.1.1 and later. Consult the official CouchDB documentation for detailed
-// usage instructions. http://docs.couchdb.org/en/2.1.1/api/database/find.html#pagination
+// 2.1.1 and later, and with Search. Consult the official CouchDB
+// documentation for detailed usage instructions.
+// http://docs.couchdb.org/en/2.1.1/api/database/find.html#pagination
 func (r *Rows) Bookmark() string {
 	if b, ok := r.rowsi.(driver.Bookmarker); ok {
 		return b.Bookmark()
 	}
 	return ""
 }
+
+// Facets is the faceted search results for a single field, mapping each
+// value or range name to the number of matching documents.
+type Facets map[string]int64
+
+// Counts returns the facet counts, by field and value, requested with the
+// counts option to Search. Like TotalRows, this value is only guaranteed to be
+// set after all result rows have been enumerated through by Next. Nil is
+// returned if the driver does not support facets.
+func (r *Rows) Counts() map[string]Facets {
+	if f, ok := r.rowsi.(driver.Faceter); ok {
+		return convertFacets(f.Counts())
+	}
+	return nil
+}
+
+// Ranges returns the facet counts, by field and range name, requested with the
+// ranges option to Search. Like TotalRows, this value is only guaranteed to be
+// set after all result rows have been enumerated through by Next. Nil is
+// returned if the driver does not support facets.
+func (r *Rows) Ranges() map[string]Facets {
+	if f, ok := r.rowsi.(driver.Faceter); ok {
+		return convertFacets(f.Ranges())
+	}
+	return nil
+}
+
+func convertFacets(in map[string]driver.Facets) map[string]Facets {
+	if in == nil {
+		return nil
+	}
+	out := make(map[string]Facets, len(in))
+	for field, facets := range in {
+		out[field] = Facets(facets)
+	}
+	return out
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/flimzy/diff"
 	"github.com/flimzy/testy"
@@ -66,6 +67,44 @@ func TestRowsClose(t *testing.T) {
 	testy.Error(t, expected, err)
 }
 
+func TestRowsNextError(t *testing.T) {
+	var closed bool
+	r := newRows(context.Background(), &mock.Rows{
+		NextFunc:  func(_ *driver.Row) error { return errors.New("read error") },
+		CloseFunc: func() error { closed = true; return nil },
+	})
+	if r.Next() {
+		t.Fatal("Next should return false on error")
+	}
+	testy.Error(t, "read error", r.Err())
+	if !closed {
+		t.Error("Underlying rows should be closed after an error")
+	}
+}
+
+func TestRowsCloseEarly(t *testing.T) {
+	var closed bool
+	r := newRows(context.Background(), &mock.Rows{
+		NextFunc:  func(_ *driver.Row) error { return nil },
+		CloseFunc: func() error { closed = true; return nil },
+	})
+	if !r.Next() {
+		t.Fatal("Expected a row")
+	}
+	if err := r.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if !closed {
+		t.Error("Close should close the underlying rows")
+	}
+	if r.Next() {
+		t.Error("Next should return false after Close")
+	}
+	if err := r.Err(); err != nil {
+		t.Errorf("Unexpected error after early Close: %s", err)
+	}
+}
+
 func TestRowsIteratorNext(t *testing.T) {
 	expected := "foo error"
 	r := &rowsIterator{
@@ -259,6 +298,30 @@ func TestRowsScanKey(t *testing.T) {
 	}
 }
 
+func TestRowsScanKeyRowErrorUnlocks(t *testing.T) {
+	rows := &Rows{
+		iter: &iter{
+			ready: true,
+			curVal: &driver.Row{
+				Error: errors.New("row error"),
+			},
+		},
+	}
+	if err := rows.ScanKey(new(interface{})); err == nil {
+		t.Fatal("Expected an error")
+	}
+	locked := make(chan struct{})
+	go func() {
+		rows.mu.Lock()
+		close(locked)
+	}()
+	select {
+	case <-locked:
+	case <-time.After(time.Second):
+		t.Fatal("ScanKey did not release the read lock")
+	}
+}
+
 func TestRowsGetters(t *testing.T) {
 	id := "foo"
 	key := []byte("[1234]")
@@ -371,3 +434,31 @@ func TestBookmark(t *testing.T) {
 		}
 	})
 }
+
+func TestFacets(t *testing.T) {
+	t.Run("Faceter", func(t *testing.T) {
+		r := newRows(context.Background(), &mock.Faceter{
+			CountsFunc: func() map[string]driver.Facets {
+				return map[string]driver.Facets{"type": {"book": 3, "film": 1}}
+			},
+			RangesFunc: func() map[string]driver.Facets {
+				return map[string]driver.Facets{"price": {"cheap": 2, "expensive": 0}}
+			},
+		})
+		if d := diff.Interface(map[string]Facets{"type": {"book": 3, "film": 1}}, r.Counts()); d != nil {
+			t.Errorf("Unexpected counts:\n%s", d)
+		}
+		if d := diff.Interface(map[string]Facets{"price": {"cheap": 2, "expensive": 0}}, r.Ranges()); d != nil {
+			t.Errorf("Unexpected ranges:\n%s", d)
+		}
+	})
+	t.Run("Non Faceter", func(t *testing.T) {
+		r := newRows(context.Background(), &mock.Rows{})
+		if c := r.Counts(); c != nil {
+			t.Errorf("Unexpected counts: %v", c)
+		}
+		if c := r.Ranges(); c != nil {
+			t.Errorf("Unexpected ranges: %v", c)
+		}
+	})
+}
@@ -0,0 +1,201 @@
+package kivik
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/go-kivik/kivik/driver"
+	"github.com/go-kivik/kivik/errors"
+)
+
+// SchedulerEvent is a single event in the history of a replication job.
+type SchedulerEvent struct {
+	// Timestamp is the time the event occurred.
+	Timestamp time.Time
+	// Type is the event type, such as "added", "started" or "crashed".
+	Type string
+	// Reason is the reason for a crash, if any.
+	Reason string
+}
+
+// SchedulerJob represents a replication job managed by the replication
+// scheduler.
+// See http://docs.couchdb.org/en/2.1.1/api/server/common.html#scheduler-jobs
+type SchedulerJob struct {
+	// Database is the replicator database containing the replication
+	// document, or empty for jobs started with /_replicate.
+	Database string
+	// DocID is the ID of the replication document, if any.
+	DocID string
+	// ID is the replication ID.
+	ID string
+	// Node is the cluster node running the job.
+	Node string
+	// PID is the server's process ID for the job.
+	PID string
+	// Source is the replication source.
+	Source string
+	// Target is the replication target.
+	Target string
+	// StartTime is the time the job was started.
+	StartTime time.Time
+	// History is the list of events for the job, most recent first.
+	History []SchedulerEvent
+	// Info is the raw JSON job info, which may contain additional details.
+	Info json.RawMessage
+}
+
+// SchedulerDoc represents the state of a replication document, as managed by
+// the replication scheduler.
+// See http://docs.couchdb.org/en/2.1.1/api/server/common.html#scheduler-docs
+type SchedulerDoc struct {
+	// Database is the replicator database containing the document.
+	Database string
+	// DocID is the ID of the replication document.
+	DocID string
+	// ID is the replication ID, once known.
+	ID string
+	// Node is the cluster node running the replication.
+	Node string
+	// Source is the replication source.
+	Source string
+	// Target is the replication target.
+	Target string
+	// State is the replication state, such as "running" or "crashing".
+	State string
+	// ErrorCount is the number of consecutive errors for the replication.
+	ErrorCount int
+	// StartTime is the time the replication was started.
+	StartTime time.Time
+	// LastUpdated is the time the state was last updated.
+	LastUpdated time.Time
+	// Info is the raw JSON state info, which may contain additional details.
+	Info json.RawMessage
+}
+
+var schedulerNotImplemented = errors.Status(StatusNotImplemented, "kivik: driver does not support scheduler")
+
+// SchedulerJobs returns the list of replication jobs managed by the
+// replication scheduler, including the event history of each.
+//
+// If the driver or server does not support the scheduler (added in CouchDB
+// 2.1.0), the running replications are read from ActiveTasks instead. Jobs
+// built this way have no History, and options are ignored.
+func (c *Client) SchedulerJobs(ctx context.Context, options ...Options) ([]*SchedulerJob, error) {
+	if err := c.checkClosed(); err != nil {
+		return nil, err
+	}
+	opts, err := mergeOptions(options...)
+	if err != nil {
+		return nil, err
+	}
+	jobs, err := c.nativeSchedulerJobs(ctx, opts)
+	switch StatusCode(err) {
+	case StatusNotFound, StatusNotImplemented:
+		return c.fallbackSchedulerJobs(ctx)
+	}
+	return jobs, err
+}
+
+// activeReplication holds the fields of a replication task, as reported by
+// /_active_tasks, which are not part of Task.
+type activeReplication struct {
+	Database      string `json:"database"`
+	DocID         string `json:"doc_id"`
+	ReplicationID string `json:"replication_id"`
+	Node          string `json:"node"`
+	Source        string `json:"source"`
+	Target        string `json:"target"`
+}
+
+func (c *Client) fallbackSchedulerJobs(ctx context.Context) ([]*SchedulerJob, error) {
+	tasks, err := c.ActiveTasks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	jobs := make([]*SchedulerJob, 0, len(tasks))
+	for _, task := range tasks {
+		if task.Type != "replication" {
+			continue
+		}
+		var rep activeReplication
+		if err := json.Unmarshal(task.RawResponse, &rep); err != nil {
+			return nil, errors.WrapStatus(StatusBadResponse, err)
+		}
+		jobs = append(jobs, &SchedulerJob{
+			Database:  rep.Database,
+			DocID:     rep.DocID,
+			ID:        rep.ReplicationID,
+			Node:      rep.Node,
+			PID:       task.PID,
+			Source:    rep.Source,
+			Target:    rep.Target,
+			StartTime: task.StartedOn,
+			Info:      task.RawResponse,
+		})
+	}
+	return jobs, nil
+}
+
+func (c *Client) nativeSchedulerJobs(ctx context.Context, opts Options) ([]*SchedulerJob, error) {
+	scheduler, ok := c.driverClient.(driver.Scheduler)
+	if !ok {
+		return nil, schedulerNotImplemented
+	}
+	djobs, err := scheduler.SchedulerJobs(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	jobs := make([]*SchedulerJob, len(djobs))
+	for i, job := range djobs {
+		var history []SchedulerEvent
+		if job.History != nil {
+			history = make([]SchedulerEvent, len(job.History))
+			for j, event := range job.History {
+				history[j] = SchedulerEvent(event)
+			}
+		}
+		jobs[i] = &SchedulerJob{
+			Database:  job.Database,
+			DocID:     job.DocID,
+			ID:        job.ID,
+			Node:      job.Node,
+			PID:       job.PID,
+			Source:    job.Source,
+			Target:    job.Target,
+			StartTime: job.StartTime,
+			History:   history,
+			Info:      job.Info,
+		}
+	}
+	return jobs, nil
+}
+
+// SchedulerDocs returns the state of the replication documents managed by the
+// replication scheduler, including error counts. This requires CouchDB 2.1.0
+// or later; older servers do not track replication document state, so there
+// is no fallback.
+func (c *Client) SchedulerDocs(ctx context.Context, options ...Options) ([]*SchedulerDoc, error) {
+	if err := c.checkClosed(); err != nil {
+		return nil, err
+	}
+	scheduler, ok := c.driverClient.(driver.Scheduler)
+	if !ok {
+		return nil, schedulerNotImplemented
+	}
+	opts, err := mergeOptions(options...)
+	if err != nil {
+		return nil, err
+	}
+	ddocs, err := scheduler.SchedulerDocs(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	docs := make([]*SchedulerDoc, len(ddocs))
+	for i, doc := range ddocs {
+		d := SchedulerDoc(*doc)
+		docs[i] = &d
+	}
+	return docs, nil
+}
@@ -0,0 +1,224 @@
+package kivik
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/flimzy/diff"
+	"github.com/flimzy/testy"
+	"github.com/go-kivik/kivik/driver"
+	kerrors "github.com/go-kivik/kivik/errors"
+	"github.com/go-kivik/kivik/mock"
+)
+
+// schedulerTasker is a driver.Client which supports both the scheduler and
+// active tasks.
+type schedulerTasker struct {
+	*mock.Scheduler
+	tasks func(context.Context) ([]*driver.Task, error)
+}
+
+var _ driver.ActiveTasker = &schedulerTasker{}
+
+func (c *schedulerTasker) ActiveTasks(ctx context.Context) ([]*driver.Task, error) {
+	return c.tasks(ctx)
+}
+
+func TestSchedulerJobs(t *testing.T) {
+	tests := []struct {
+		name     string
+		client   *Client
+		options  Options
+		expected []*SchedulerJob
+		status   int
+		err      string
+	}{
+		{
+			name: "non-Scheduler, non-ActiveTasker",
+			client: &Client{
+				driverClient: &mock.Client{},
+			},
+			status: StatusNotImplemented,
+			err:    "kivik: driver does not support active tasks",
+		},
+		{
+			name: "fallback to active tasks",
+			client: &Client{
+				driverClient: &mock.ActiveTasker{
+					ActiveTasksFunc: func(_ context.Context) ([]*driver.Task, error) {
+						return []*driver.Task{
+							{Type: "indexer", PID: "<0.1.0>"},
+							{
+								Type:        "replication",
+								PID:         "<0.2.0>",
+								StartedOn:   parseTime(t, "2018-01-01T00:00:00Z"),
+								RawResponse: []byte(`{"type":"replication","doc_id":"foo","replication_id":"abc+continuous","source":"http://a/","target":"http://b/"}`),
+							},
+						}, nil
+					},
+				},
+			},
+			expected: []*SchedulerJob{
+				{
+					DocID:     "foo",
+					ID:        "abc+continuous",
+					PID:       "<0.2.0>",
+					Source:    "http://a/",
+					Target:    "http://b/",
+					StartTime: parseTime(t, "2018-01-01T00:00:00Z"),
+					Info:      []byte(`{"type":"replication","doc_id":"foo","replication_id":"abc+continuous","source":"http://a/","target":"http://b/"}`),
+				},
+			},
+		},
+		{
+			name: "fallback due to old server",
+			client: &Client{
+				driverClient: &schedulerTasker{
+					Scheduler: &mock.Scheduler{
+						SchedulerJobsFunc: func(_ context.Context, _ map[string]interface{}) ([]*driver.SchedulerJob, error) {
+							return nil, kerrors.Status(StatusNotFound, "not found")
+						},
+					},
+					tasks: func(_ context.Context) ([]*driver.Task, error) {
+						return []*driver.Task{{Type: "replication", RawResponse: []byte(`{"replication_id":"abc"}`)}}, nil
+					},
+				},
+			},
+			expected: []*SchedulerJob{
+				{ID: "abc", Info: []byte(`{"replication_id":"abc"}`)},
+			},
+		},
+		{
+			name: "fallback invalid task",
+			client: &Client{
+				driverClient: &mock.ActiveTasker{
+					ActiveTasksFunc: func(_ context.Context) ([]*driver.Task, error) {
+						return []*driver.Task{{Type: "replication", RawResponse: []byte(`invalid`)}}, nil
+					},
+				},
+			},
+			status: StatusBadResponse,
+			err:    "invalid character 'i' looking for beginning of value",
+		},
+		{
+			name: "error",
+			client: &Client{
+				driverClient: &mock.Scheduler{
+					SchedulerJobsFunc: func(_ context.Context, _ map[string]interface{}) ([]*driver.SchedulerJob, error) {
+						return nil, errors.New("jobs error")
+					},
+				},
+			},
+			status: StatusInternalServerError,
+			err:    "jobs error",
+		},
+		{
+			name: "success",
+			client: &Client{
+				driverClient: &mock.Scheduler{
+					SchedulerJobsFunc: func(_ context.Context, opts map[string]interface{}) ([]*driver.SchedulerJob, error) {
+						if d := diff.Interface(testOptions, opts); d != nil {
+							return nil, fmt.Errorf("Unexpected options:\n%s", d)
+						}
+						return []*driver.SchedulerJob{
+							{
+								Database:  "_replicator",
+								DocID:     "foo",
+								ID:        "abc+continuous",
+								StartTime: parseTime(t, "2018-01-01T00:00:00Z"),
+								History: []driver.SchedulerEvent{
+									{Timestamp: parseTime(t, "2018-01-01T00:00:00Z"), Type: "started"},
+								},
+							},
+							{ID: "def"},
+						}, nil
+					},
+				},
+			},
+			options: testOptions,
+			expected: []*SchedulerJob{
+				{
+					Database:  "_replicator",
+					DocID:     "foo",
+					ID:        "abc+continuous",
+					StartTime: parseTime(t, "2018-01-01T00:00:00Z"),
+					History: []SchedulerEvent{
+						{Timestamp: parseTime(t, "2018-01-01T00:00:00Z"), Type: "started"},
+					},
+				},
+				{ID: "def"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := test.client.SchedulerJobs(context.Background(), test.options)
+			testy.StatusError(t, test.err, test.status, err)
+			if d := diff.Interface(test.expected, result); d != nil {
+				t.Error(d)
+			}
+		})
+	}
+}
+
+func TestSchedulerDocs(t *testing.T) {
+	tests := []struct {
+		name     string
+		client   *Client
+		options  Options
+		expected []*SchedulerDoc
+		status   int
+		err      string
+	}{
+		{
+			name: "non-Scheduler",
+			client: &Client{
+				driverClient: &mock.Client{},
+			},
+			status: StatusNotImplemented,
+			err:    "kivik: driver does not support scheduler",
+		},
+		{
+			name: "error",
+			client: &Client{
+				driverClient: &mock.Scheduler{
+					SchedulerDocsFunc: func(_ context.Context, _ map[string]interface{}) ([]*driver.SchedulerDoc, error) {
+						return nil, errors.New("docs error")
+					},
+				},
+			},
+			status: StatusInternalServerError,
+			err:    "docs error",
+		},
+		{
+			name: "success",
+			client: &Client{
+				driverClient: &mock.Scheduler{
+					SchedulerDocsFunc: func(_ context.Context, opts map[string]interface{}) ([]*driver.SchedulerDoc, error) {
+						if d := diff.Interface(testOptions, opts); d != nil {
+							return nil, fmt.Errorf("Unexpected options:\n%s", d)
+						}
+						return []*driver.SchedulerDoc{
+							{Database: "_replicator", DocID: "foo", State: "crashing", ErrorCount: 3},
+						}, nil
+					},
+				},
+			},
+			options: testOptions,
+			expected: []*SchedulerDoc{
+				{Database: "_replicator", DocID: "foo", State: "crashing", ErrorCount: 3},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := test.client.SchedulerDocs(context.Background(), test.options)
+			testy.StatusError(t, test.err, test.status, err)
+			if d := diff.Interface(test.expected, result); d != nil {
+				t.Error(d)
+			}
+		})
+	}
+}
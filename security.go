@@ -1,5 +1,11 @@
 package kivik
 
+import (
+	"encoding/json"
+
+	"github.com/go-kivik/kivik/driver"
+)
+
 // Members represents the members of a database security document.
 type Members struct {
 	Names []string `json:"names,omitempty"`
@@ -10,4 +16,33 @@ type Members struct {
 type Security struct {
 	Admins  Members `json:"admins"`
 	Members Members `json:"members"`
+	// Extra holds any other top-level fields of the security document, such
+	// as those used by custom security extensions. They are preserved when
+	// the document is passed back to SetSecurity.
+	Extra map[string]interface{} `json:"-"`
+}
+
+// MarshalJSON satisfies the json.Marshaler interface, including any Extra
+// fields in the output.
+func (s Security) MarshalJSON() ([]byte, error) {
+	return json.Marshal(driver.Security{
+		Admins:  driver.Members(s.Admins),
+		Members: driver.Members(s.Members),
+		Extra:   s.Extra,
+	})
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface, storing any fields
+// other than admins and members in Extra.
+func (s *Security) UnmarshalJSON(data []byte) error {
+	var sec driver.Security
+	if err := json.Unmarshal(data, &sec); err != nil {
+		return err
+	}
+	*s = Security{
+		Admins:  Members(sec.Admins),
+		Members: Members(sec.Members),
+		Extra:   sec.Extra,
+	}
+	return nil
 }
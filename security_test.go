@@ -0,0 +1,71 @@
+package kivik
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/flimzy/diff"
+	"github.com/flimzy/testy"
+)
+
+func TestSecurityJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected *Security
+		output   string
+		err      string
+	}{
+		{
+			name:   "invalid JSON",
+			input:  "invalid",
+			output: "null",
+			err:    "invalid character 'i' looking for beginning of value",
+		},
+		{
+			name:     "empty",
+			input:    "{}",
+			expected: &Security{},
+			output:   `{"admins":{},"members":{}}`,
+		},
+		{
+			name:  "standard fields",
+			input: `{"admins":{"names":["a"]},"members":{"roles":["b"]}}`,
+			expected: &Security{
+				Admins:  Members{Names: []string{"a"}},
+				Members: Members{Roles: []string{"b"}},
+			},
+			output: `{"admins":{"names":["a"]},"members":{"roles":["b"]}}`,
+		},
+		{
+			name:  "extra fields",
+			input: `{"admins":{"names":["a"]},"cloudant":{"nobody":["_reader"]}}`,
+			expected: &Security{
+				Admins: Members{Names: []string{"a"}},
+				Extra: map[string]interface{}{
+					"cloudant": map[string]interface{}{
+						"nobody": []interface{}{"_reader"},
+					},
+				},
+			},
+			output: `{"admins":{"names":["a"]},"cloudant":{"nobody":["_reader"]},"members":{}}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var result *Security
+			err := json.Unmarshal([]byte(test.input), &result)
+			testy.Error(t, test.err, err)
+			if d := diff.Interface(test.expected, result); d != nil {
+				t.Fatal(d)
+			}
+			out, err := json.Marshal(result)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(out) != test.output {
+				t.Errorf("Unexpected output: %s", out)
+			}
+		})
+	}
+}
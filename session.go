@@ -30,6 +30,9 @@ type Session struct {
 
 // Session returns information about the currently authenticated user.
 func (c *Client) Session(ctx context.Context) (*Session, error) {
+	if err := c.checkClosed(); err != nil {
+		return nil, err
+	}
 	if sessioner, ok := c.driverClient.(driver.Sessioner); ok {
 		session, err := sessioner.Session(ctx)
 		if err != nil {
@@ -0,0 +1,55 @@
+package kivik
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/go-kivik/kivik/driver"
+	"github.com/go-kivik/kivik/errors"
+)
+
+// Task represents a single running task, such as a compaction, indexing or
+// replication process, as reported by the server.
+type Task struct {
+	// Type is the task type, such as "database_compaction", "indexer" or
+	// "replication".
+	Type string
+	// Database is the name of the database the task operates on, if any.
+	Database string
+	// PID is the server's process ID for the task.
+	PID string
+	// Progress is the task's progress, as a percentage, if known.
+	Progress float64
+	// StartedOn is the time the task was started.
+	StartedOn time.Time
+	// UpdatedOn is the time the task's status was last updated.
+	UpdatedOn time.Time
+	// RawResponse is the raw JSON description of the task, useful to read
+	// fields specific to the task type.
+	//
+	// For the format of this document, see
+	// http://docs.couchdb.org/en/2.1.1/api/server/common.html#active-tasks
+	RawResponse json.RawMessage
+}
+
+// ActiveTasks returns a list of the tasks currently running on the server.
+func (c *Client) ActiveTasks(ctx context.Context) ([]*Task, error) {
+	if err := c.checkClosed(); err != nil {
+		return nil, err
+	}
+	tasker, ok := c.driverClient.(driver.ActiveTasker)
+	if !ok {
+		return nil, errors.Status(StatusNotImplemented, "kivik: driver does not support active tasks")
+	}
+	dtasks, err := tasker.ActiveTasks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tasks := make([]*Task, len(dtasks))
+	for i, task := range dtasks {
+		t := Task(*task)
+		tasks[i] = &t
+	}
+	return tasks, nil
+}
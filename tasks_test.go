@@ -0,0 +1,83 @@
+package kivik
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/flimzy/diff"
+	"github.com/flimzy/testy"
+	"github.com/go-kivik/kivik/driver"
+	"github.com/go-kivik/kivik/mock"
+)
+
+func TestActiveTasks(t *testing.T) {
+	tests := []struct {
+		name     string
+		client   *Client
+		expected []*Task
+		status   int
+		err      string
+	}{
+		{
+			name: "non-ActiveTasker",
+			client: &Client{
+				driverClient: &mock.Client{},
+			},
+			status: StatusNotImplemented,
+			err:    "kivik: driver does not support active tasks",
+		},
+		{
+			name: "error",
+			client: &Client{
+				driverClient: &mock.ActiveTasker{
+					ActiveTasksFunc: func(_ context.Context) ([]*driver.Task, error) {
+						return nil, errors.New("tasks error")
+					},
+				},
+			},
+			status: StatusInternalServerError,
+			err:    "tasks error",
+		},
+		{
+			name: "success",
+			client: &Client{
+				driverClient: &mock.ActiveTasker{
+					ActiveTasksFunc: func(_ context.Context) ([]*driver.Task, error) {
+						return []*driver.Task{
+							{
+								Type:        "indexer",
+								Database:    "foo",
+								PID:         "<0.1.0>",
+								Progress:    60,
+								StartedOn:   parseTime(t, "2018-01-01T00:00:00Z"),
+								UpdatedOn:   parseTime(t, "2018-01-01T00:01:00Z"),
+								RawResponse: []byte(`{"type":"indexer"}`),
+							},
+						}, nil
+					},
+				},
+			},
+			expected: []*Task{
+				{
+					Type:        "indexer",
+					Database:    "foo",
+					PID:         "<0.1.0>",
+					Progress:    60,
+					StartedOn:   parseTime(t, "2018-01-01T00:00:00Z"),
+					UpdatedOn:   parseTime(t, "2018-01-01T00:01:00Z"),
+					RawResponse: []byte(`{"type":"indexer"}`),
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := test.client.ActiveTasks(context.Background())
+			testy.StatusError(t, test.err, test.status, err)
+			if d := diff.Interface(test.expected, result); d != nil {
+				t.Error(d)
+			}
+		})
+	}
+}
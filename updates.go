@@ -76,15 +76,19 @@ func (f *DBUpdates) Seq() string {
 	return f.curVal.(*driver.DBUpdate).Seq
 }
 
-// DBUpdates begins polling for database updates.
-func (c *Client) DBUpdates() (*DBUpdates, error) {
+// DBUpdates begins polling for database updates. The feed remains open until
+// explicitly closed, or the context is cancelled.
+func (c *Client) DBUpdates(ctx context.Context) (*DBUpdates, error) {
+	if err := c.checkClosed(); err != nil {
+		return nil, err
+	}
 	updater, ok := c.driverClient.(driver.DBUpdater)
 	if !ok {
 		return nil, errors.Status(StatusNotImplemented, "kivik: driver does not implement DBUpdater")
 	}
-	updatesi, err := updater.DBUpdates()
+	updatesi, err := updater.DBUpdates(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return newDBUpdates(context.Background(), updatesi), nil
+	return newDBUpdates(ctx, updatesi), nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/flimzy/diff"
 	"github.com/flimzy/testy"
@@ -177,7 +178,7 @@ func TestDBUpdates(t *testing.T) {
 			name: "db error",
 			client: &Client{
 				driverClient: &mock.DBUpdater{
-					DBUpdatesFunc: func() (driver.DBUpdates, error) {
+					DBUpdatesFunc: func(_ context.Context) (driver.DBUpdates, error) {
 						return nil, errors.New("db error")
 					},
 				},
@@ -189,7 +190,7 @@ func TestDBUpdates(t *testing.T) {
 			name: "success",
 			client: &Client{
 				driverClient: &mock.DBUpdater{
-					DBUpdatesFunc: func() (driver.DBUpdates, error) {
+					DBUpdatesFunc: func(_ context.Context) (driver.DBUpdates, error) {
 						return &mock.DBUpdates{ID: "a"}, nil
 					},
 				},
@@ -207,7 +208,7 @@ func TestDBUpdates(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			result, err := test.client.DBUpdates()
+			result, err := test.client.DBUpdates(context.Background())
 			testy.StatusError(t, test.err, test.status, err)
 			result.cancel = nil // Determinism
 			if d := diff.Interface(test.expected, result); d != nil {
@@ -216,3 +217,34 @@ func TestDBUpdates(t *testing.T) {
 		})
 	}
 }
+
+func TestDBUpdatesCancel(t *testing.T) {
+	closed := make(chan struct{})
+	client := &Client{
+		driverClient: &mock.DBUpdater{
+			DBUpdatesFunc: func(_ context.Context) (driver.DBUpdates, error) {
+				return &mock.DBUpdates{
+					CloseFunc: func() error {
+						close(closed)
+						return nil
+					},
+				}, nil
+			},
+		},
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	updates, err := client.DBUpdates(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cancel()
+	select {
+	case <-closed:
+	case <-time.After(time.Second):
+		t.Fatal("feed not closed after context cancellation")
+	}
+	if updates.Next() {
+		t.Error("Next should return false after cancellation")
+	}
+	testy.Error(t, context.Canceled.Error(), updates.Err())
+}
@@ -0,0 +1,72 @@
+package kivik
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-kivik/kivik/driver"
+	"github.com/go-kivik/kivik/errors"
+)
+
+// defaultMaxUUIDCount is CouchDB's default max_count, used when the server's
+// configured limit cannot be read.
+const defaultMaxUUIDCount = 1000
+
+// UUIDs returns count UUIDs generated by the server, which may be used as
+// document IDs without risk of collision. count must be positive.
+//
+// Requests larger than the server's uuids/max_count setting are split into
+// several requests. The limit is read with ConfigValue, where the driver
+// supports it, which generally requires admin access; otherwise CouchDB's
+// default of 1000 is assumed.
+// See http://docs.couchdb.org/en/2.1.1/api/server/common.html#uuids
+func (c *Client) UUIDs(ctx context.Context, count int) ([]string, error) {
+	if err := c.checkClosed(); err != nil {
+		return nil, err
+	}
+	if count <= 0 {
+		return nil, errors.Statusf(StatusBadAPICall, "kivik: invalid UUID count %d", count)
+	}
+	uuider, ok := c.driverClient.(driver.UUIDer)
+	if !ok {
+		return nil, errors.Status(StatusNotImplemented, "kivik: driver does not support UUIDs")
+	}
+	maxCount := 1
+	if count > 1 {
+		maxCount = c.maxUUIDCount(ctx)
+	}
+	uuids := make([]string, 0, count)
+	for len(uuids) < count {
+		n := count - len(uuids)
+		if n > maxCount {
+			n = maxCount
+		}
+		batch, err := uuider.UUIDs(ctx, n)
+		if err != nil {
+			return nil, err
+		}
+		if len(batch) == 0 {
+			return nil, errors.Status(StatusBadResponse, "kivik: no UUIDs returned")
+		}
+		uuids = append(uuids, batch...)
+	}
+	return uuids[:count], nil
+}
+
+// maxUUIDCount returns the server's configured uuids/max_count, or
+// defaultMaxUUIDCount if it cannot be determined.
+func (c *Client) maxUUIDCount(ctx context.Context) int {
+	configer, ok := c.driverClient.(driver.Configer)
+	if !ok {
+		return defaultMaxUUIDCount
+	}
+	value, err := configer.ConfigValue(ctx, LocalNode, "uuids", "max_count")
+	if err != nil {
+		return defaultMaxUUIDCount
+	}
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit <= 0 {
+		return defaultMaxUUIDCount
+	}
+	return limit
+}
@@ -0,0 +1,164 @@
+package kivik
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/flimzy/testy"
+	"github.com/go-kivik/kivik/driver"
+	kerrors "github.com/go-kivik/kivik/errors"
+	"github.com/go-kivik/kivik/mock"
+)
+
+func TestUUIDs(t *testing.T) {
+	tests := []struct {
+		name     string
+		client   *Client
+		count    int
+		expected int
+		status   int
+		err      string
+	}{
+		{
+			name:   "zero count",
+			client: &Client{driverClient: &mock.UUIDer{}},
+			status: StatusBadAPICall,
+			err:    "kivik: invalid UUID count 0",
+		},
+		{
+			name:   "negative count",
+			client: &Client{driverClient: &mock.UUIDer{}},
+			count:  -1,
+			status: StatusBadAPICall,
+			err:    "kivik: invalid UUID count -1",
+		},
+		{
+			name:   "non-UUIDer",
+			client: &Client{driverClient: &mock.Client{}},
+			count:  1,
+			status: StatusNotImplemented,
+			err:    "kivik: driver does not support UUIDs",
+		},
+		{
+			name: "error",
+			client: &Client{
+				driverClient: &mock.UUIDer{
+					UUIDsFunc: func(_ context.Context, _ int) ([]string, error) {
+						return nil, errors.New("uuids error")
+					},
+				},
+			},
+			count:  1,
+			status: StatusInternalServerError,
+			err:    "uuids error",
+		},
+		{
+			name: "empty response",
+			client: &Client{
+				driverClient: &mock.UUIDer{
+					UUIDsFunc: func(_ context.Context, _ int) ([]string, error) {
+						return nil, nil
+					},
+				},
+			},
+			count:  1,
+			status: StatusBadResponse,
+			err:    "kivik: no UUIDs returned",
+		},
+		{
+			name:     "single request",
+			client:   &Client{driverClient: &mock.UUIDer{UUIDsFunc: testUUIDs(3)}},
+			count:    3,
+			expected: 3,
+		},
+		{
+			name:     "chunked",
+			client:   &Client{driverClient: &mock.UUIDer{UUIDsFunc: testUUIDs(1000, 1000, 500)}},
+			count:    2500,
+			expected: 2500,
+		},
+		{
+			name: "too many returned",
+			client: &Client{
+				driverClient: &mock.UUIDer{
+					UUIDsFunc: func(_ context.Context, _ int) ([]string, error) {
+						return []string{"a", "b", "c"}, nil
+					},
+				},
+			},
+			count:    2,
+			expected: 2,
+		},
+		{
+			name: "configured limit",
+			client: &Client{driverClient: &uuidConfiger{
+				Configer: &mock.Configer{
+					ConfigValueFunc: func(_ context.Context, node, section, key string) (string, error) {
+						if node != LocalNode || section != "uuids" || key != "max_count" {
+							return "", fmt.Errorf("Unexpected config key: %s/%s/%s", node, section, key)
+						}
+						return "100", nil
+					},
+				},
+				uuids: testUUIDs(100, 100, 50),
+			}},
+			count:    250,
+			expected: 250,
+		},
+		{
+			name: "config error",
+			client: &Client{driverClient: &uuidConfiger{
+				Configer: &mock.Configer{
+					ConfigValueFunc: func(_ context.Context, _, _, _ string) (string, error) {
+						return "", kerrors.Status(StatusUnauthorized, "unauthorized")
+					},
+				},
+				uuids: testUUIDs(1000, 500),
+			}},
+			count:    1500,
+			expected: 1500,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := test.client.UUIDs(context.Background(), test.count)
+			testy.StatusError(t, test.err, test.status, err)
+			if len(result) != test.expected {
+				t.Errorf("Expected %d UUIDs, got %d", test.expected, len(result))
+			}
+		})
+	}
+}
+
+// uuidConfiger is a driver.Client which supports both UUIDs and Config.
+type uuidConfiger struct {
+	*mock.Configer
+	uuids func(context.Context, int) ([]string, error)
+}
+
+var _ driver.UUIDer = &uuidConfiger{}
+
+func (c *uuidConfiger) UUIDs(ctx context.Context, count int) ([]string, error) {
+	return c.uuids(ctx, count)
+}
+
+// testUUIDs returns a UUIDsFunc which expects to be called with each of the
+// counts in turn, and returns an error for any other request.
+func testUUIDs(counts ...int) func(context.Context, int) ([]string, error) {
+	return func(_ context.Context, count int) ([]string, error) {
+		if len(counts) == 0 {
+			return nil, fmt.Errorf("Unexpected request for %d UUIDs", count)
+		}
+		if count != counts[0] {
+			return nil, fmt.Errorf("Expected request for %d UUIDs, got %d", counts[0], count)
+		}
+		counts = counts[1:]
+		uuids := make([]string, count)
+		for i := range uuids {
+			uuids[i] = fmt.Sprintf("uuid%d", i)
+		}
+		return uuids, nil
+	}
+}